@@ -0,0 +1,191 @@
+package beater
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/conditions"
+	"github.com/rcowham/p4dbeat/config"
+)
+
+const defaultAlertSeverity = "warning"
+
+// Fields of the command event copied to its alert events
+var alertKeyFields = []string{
+	"p4.process_key",
+	"p4.cmd",
+	"p4.pid",
+	"p4.line_no",
+	"p4.user",
+	"p4.workspace",
+	"p4.ip",
+	"p4.app",
+	"p4.args",
+	"p4.start_time",
+	"p4.end_time",
+	"p4.completed_sec",
+	"p4.cmd_error",
+}
+
+// alertRule is a configured rule with its condition compiled
+type alertRule struct {
+	name        string
+	severity    string
+	description string
+	condition   conditions.Condition
+}
+
+func newAlertRules(cfgs []config.AlertRule) ([]alertRule, error) {
+	rules := make([]alertRule, 0, len(cfgs))
+	for _, c := range cfgs {
+		cond, err := conditions.NewCondition(&c.When)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid condition for alert rule '%s': %v", c.Name, err)
+		}
+		severity := c.Severity
+		if severity == "" {
+			severity = defaultAlertSeverity
+		}
+		rules = append(rules, alertRule{
+			name:        c.Name,
+			severity:    severity,
+			description: c.Description,
+			condition:   cond,
+		})
+	}
+	return rules, nil
+}
+
+// eventValues lets conditions be checked against event fields, which are flat dotted keys.
+// A "*" in the field name matches any single part of it, e.g. p4.tbl.*.locks.write.wait.max_sec,
+// giving the largest of the matching numeric values ("any table").
+type eventValues common.MapStr
+
+// GetValue returns the value of the field
+func (m eventValues) GetValue(key string) (interface{}, error) {
+	if !strings.Contains(key, "*") {
+		if v, ok := m[key]; ok {
+			return v, nil
+		}
+		return nil, common.ErrKeyNotFound
+	}
+	pattern := strings.Split(key, ".")
+	var found interface{}
+	var max float64
+	for k, v := range m {
+		if !matchFieldPattern(pattern, k) {
+			continue
+		}
+		f, ok := toFloat(v)
+		if !ok {
+			if found == nil {
+				found = v
+			}
+			continue
+		}
+		if _, isNum := found.(float64); !isNum || f > max {
+			found, max = f, f
+		}
+	}
+	if found == nil {
+		return nil, common.ErrKeyNotFound
+	}
+	return found, nil
+}
+
+func matchFieldPattern(pattern []string, key string) bool {
+	parts := strings.Split(key, ".")
+	if len(parts) != len(pattern) {
+		return false
+	}
+	for i, p := range pattern {
+		if p != "*" && p != parts[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+// checkAlerts checks the values of the command event against the alert rules, publishing an alert
+// event for each rule it matches. The values are from before the event was pseudonymised, so rules
+// can match users etc., but alert events only have the fields of the (pseudonymised) event.
+func (bt *P4dbeat) checkAlerts(in *logInput, event *beat.Event, fields common.MapStr) {
+	values := eventValues(fields)
+	for _, r := range bt.alerts {
+		if !r.condition.Check(values) {
+			continue
+		}
+		alert := beat.Event{
+			Timestamp: event.Timestamp,
+			Fields: common.MapStr{
+				"type":              bt.name,
+				"p4.alert.rule":     r.name,
+				"p4.alert.severity": r.severity,
+			},
+		}
+		if r.description != "" {
+			alert.Fields["p4.alert.description"] = r.description
+		}
+		for _, k := range alertKeyFields {
+			if v, ok := event.Fields[k]; ok {
+				alert.Fields[k] = v
+			}
+		}
+		for k, v := range in.fields {
+			alert.Fields[k] = v
+		}
+		if id, ok := event.Meta["_id"]; ok {
+			alert.SetID(fmt.Sprintf("%v-%s", id, r.name))
+		}
+		alert.Fields = ecsAlert(bt.config.Schema, in.hostname, alert.Fields)
+		bt.publish(alert)
+		if bt.notifier != nil {
+			id := ""
+			if v, ok := alert.Meta["_id"]; ok {
+				id = fmt.Sprint(v)
+			}
+			bt.notifier.notify(alertInfo{
+				id:          id,
+				rule:        r.name,
+				severity:    r.severity,
+				description: r.description,
+				serverID:    in.serverID(),
+				time:        event.Timestamp,
+				summary:     alertSummary(event),
+			})
+		}
+	}
+}
+
+// alertSummary describes the command for notifications, e.g.
+//
+//	user-sync by fred@fred_ws (pid 1234) at 2020-05-11T16:12:47Z took 301.2s: //depot/main/...
+func alertSummary(event *beat.Event) string {
+	f := event.Fields
+	s := fmt.Sprintf("%v by %v@%v (pid %v) at %s", f["p4.cmd"], f["p4.user"], f["p4.workspace"],
+		f["p4.pid"], event.Timestamp.UTC().Format(time.RFC3339))
+	if v, ok := f["p4.completed_sec"]; ok {
+		s += fmt.Sprintf(" took %vs", v)
+	}
+	if v, ok := f["p4.args"]; ok && v != "" {
+		s += fmt.Sprintf(": %v", v)
+	}
+	return s
+}
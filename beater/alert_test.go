@@ -0,0 +1,86 @@
+//go:build !integration
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/conditions"
+)
+
+// Table fields of a command which locked db.rev and db.have
+var alertTestFields = common.MapStr{
+	"p4.cmd":                                 "user-sync",
+	"p4.completed_sec":                       float32(2.5),
+	"p4.tbl.db_rev.locks.read.wait.max_sec":  int64(3),
+	"p4.tbl.db_rev.locks.write.wait.max_sec": 0.5,
+	"p4.tbl.db_have.locks.read.wait.max_sec": 12.5,
+	"p4.tbl.db_have.name":                    "db.have",
+}
+
+func TestEventValues(t *testing.T) {
+	tests := []struct {
+		key  string
+		want interface{} // nil if not found
+	}{
+		{"p4.cmd", "user-sync"},
+		{"p4.completed_sec", float32(2.5)},
+		{"p4.user", nil},
+		{"p4.tbl.*.locks.read.wait.max_sec", 12.5},           // largest of int and float
+		{"p4.tbl.*.locks.write.wait.max_sec", 0.5},           // single match
+		{"p4.tbl.*.locks.*.wait.max_sec", 12.5},              // several wildcards
+		{"p4.tbl.*.locks.write.held.max_sec", nil},           // no match
+		{"p4.tbl.*.locks.read.wait", nil},                    // whole parts only
+		{"p4.tbl.*.locks.read.wait.max_sec.extra", nil},      // parts must all match
+		{"p4.tbl.*.name", "db.have"},                         // non numeric value
+		{"p4.*", 2.5},                                        // numeric values preferred
+		{"*.tbl.db_rev.locks.read.wait.max_sec", float64(3)}, // converted to float
+	}
+	for _, tt := range tests {
+		got, err := eventValues(alertTestFields).GetValue(tt.key)
+		if tt.want == nil {
+			if err != common.ErrKeyNotFound {
+				t.Errorf("GetValue(%s) = %#v, %v, want not found", tt.key, got, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("GetValue(%s) = %#v, %v, want %#v", tt.key, got, err, tt.want)
+		}
+	}
+}
+
+func TestEventValuesRange(t *testing.T) {
+	tests := []struct {
+		name string
+		when map[string]interface{}
+		want bool
+	}{
+		{"any table over", map[string]interface{}{"range.p4.tbl.*.locks.read.wait.max_sec.gte": 10}, true},
+		{"any table under", map[string]interface{}{"range.p4.tbl.*.locks.read.wait.max_sec.lt": 10}, false},
+		{"all tables under", map[string]interface{}{"range.p4.tbl.*.locks.write.wait.max_sec.lt": 1}, true},
+		{"int value", map[string]interface{}{"range.p4.tbl.db_rev.locks.read.wait.max_sec.gt": 2}, true},
+		{"float32 value", map[string]interface{}{"range.p4.completed_sec.gte": 2.5}, true},
+		{"missing field", map[string]interface{}{"range.p4.tbl.*.locks.write.held.max_sec.gte": 0}, false},
+		{"missing field negated", map[string]interface{}{"not.range.p4.tbl.*.locks.write.held.max_sec.gte": 0}, true},
+		{"with equals", map[string]interface{}{
+			"and": []map[string]interface{}{
+				{"equals.p4.cmd": "user-sync"},
+				{"range.p4.tbl.*.locks.*.wait.max_sec.gt": 12},
+			}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := conditionConfig(t, tt.when)
+			cond, err := conditions.NewCondition(&c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := cond.Check(eventValues(alertTestFields)); got != tt.want {
+				t.Errorf("%v = %v, want %v", cond, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package beater
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+)
+
+// The app string is set by the client from its program name and the P4API version, e.g.
+//
+//	p4/2016.2/LINUX26X86_64/1468155
+//	P4/DARWIN90X86_64/2018.1/1660568
+//	P4V/NTX64/2023.2/2485620/v96
+//	P4Python/LINUX26X86_64/2020.1/1966006 (brokered)
+//	p4jenkins/1.10.11 (P4Java/2019.1/1858733)
+//
+// The order of the parts varies, so each is recognised by its format.
+var (
+	reAppVersion  = regexp.MustCompile(`^v?\d+(\.\d+)+`)
+	reAppBuild    = regexp.MustCompile(`^\d{5,}$`)
+	reAppPlatform = regexp.MustCompile(`(?i)^(nt|linux|darwin|macosx|freebsd|solaris|sol|cygwin|mingw|aix|hpux)\w*$`)
+)
+
+// Normalised product families, checked in order against the lower case product.
+// Integrations are recognised anywhere in the app string as they often name the P4API they use.
+var appFamilies = []struct {
+	match    string
+	family   string
+	anywhere bool
+}{
+	{"jenkins", "jenkins", true},
+	{"swarm", "Swarm", true},
+	{"git-connector", "git-connector", true},
+	{"gconn", "git-connector", true},
+	{"helix4git", "git-connector", true},
+	{"git-fusion", "git-fusion", true},
+	{"gitfusion", "git-fusion", true},
+	{"p4python", "P4Python", false},
+	{"python", "P4Python", false},
+	{"p4java", "P4Java", false},
+	{"p4perl", "P4Perl", false},
+	{"p4ruby", "P4Ruby", false},
+	{"p4php", "P4PHP", false},
+	{"p4.net", "P4.NET", false},
+	{"p4api.net", "P4.NET", false},
+	{"p4vs", "P4VS", false},
+	{"p4vc", "P4V", false},
+	{"p4v", "P4V", false},
+	{"p4merge", "P4Merge", false},
+	{"p4admin", "P4Admin", false},
+	{"p4dtg", "P4DTG", false},
+	{"p4", "p4", false},
+}
+
+// appInfo is the app string split into its parts
+type appInfo struct {
+	product  string
+	platform string
+	version  string
+	build    string
+	family   string
+}
+
+// parseApp splits the app string into its parts, leaving any which aren't found empty
+func parseApp(app string) appInfo {
+	app = strings.TrimSpace(strings.Trim(app, "[]"))
+	if app == "" {
+		return appInfo{}
+	}
+	var info appInfo
+	main, extra := app, ""
+	if i := strings.Index(app, "("); i >= 0 {
+		main, extra = strings.TrimSpace(app[:i]), strings.Trim(app[i:], "() ")
+	}
+	parts := strings.Split(main, "/")
+	info.product = strings.TrimSpace(parts[0])
+	info.setParts(parts[1:])
+	if info.version == "" && extra != "" {
+		// e.g. "MyTool (P4Python/2019.1/1858733)" - use the version of the API
+		info.setParts(strings.Split(extra, "/"))
+	}
+	info.family = appFamily(info.product, app)
+	return info
+}
+
+// setParts sets the platform, version and build from the parts which look like them
+func (info *appInfo) setParts(parts []string) {
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		switch {
+		case info.build == "" && reAppBuild.MatchString(p):
+			info.build = p
+		case info.version == "" && reAppVersion.MatchString(p):
+			info.version = p
+		case info.platform == "" && reAppPlatform.MatchString(p):
+			info.platform = p
+		}
+	}
+}
+
+// appFamily returns the normalised product family, or the product if it isn't recognised
+func appFamily(product, app string) string {
+	lproduct, lapp := strings.ToLower(product), strings.ToLower(app)
+	for _, f := range appFamilies {
+		if f.anywhere && strings.Contains(lapp, f.match) {
+			return f.family
+		}
+		if !f.anywhere && lproduct == f.match {
+			return f.family
+		}
+	}
+	// e.g. "P4.NET API", "P4Python-1.0" - but not other tools named p4xxx
+	for _, f := range appFamilies {
+		if !f.anywhere && f.match != "p4" && strings.HasPrefix(lproduct, f.match) {
+			return f.family
+		}
+	}
+	return product
+}
+
+// setAppFields adds the parts of the app string to the event
+func setAppFields(event *beat.Event, app string) {
+	info := parseApp(app)
+	for k, v := range map[string]string{
+		"p4.app_product":  info.product,
+		"p4.app_platform": info.platform,
+		"p4.app_version":  info.version,
+		"p4.app_build":    info.build,
+		"p4.app_family":   info.family,
+	} {
+		if v != "" {
+			event.Fields[k] = v
+		}
+	}
+}
@@ -0,0 +1,29 @@
+//go:build !integration
+// +build !integration
+
+package beater
+
+import "testing"
+
+func TestParseApp(t *testing.T) {
+	tests := []struct {
+		app  string
+		want appInfo
+	}{
+		{"", appInfo{}},
+		{"p4/2016.2/LINUX26X86_64/1468155", appInfo{"p4", "LINUX26X86_64", "2016.2", "1468155", "p4"}},
+		{"[p4/2016.2/LINUX26X86_64/1468155]", appInfo{"p4", "LINUX26X86_64", "2016.2", "1468155", "p4"}},
+		{"P4/DARWIN90X86_64/2018.1/1660568", appInfo{"P4", "DARWIN90X86_64", "2018.1", "1660568", "p4"}},
+		{"P4V/NTX64/2023.2/2485620/v96", appInfo{"P4V", "NTX64", "2023.2", "2485620", "P4V"}},
+		{"P4Python/LINUX26X86_64/2020.1/1966006 (brokered)", appInfo{"P4Python", "LINUX26X86_64", "2020.1", "1966006", "P4Python"}},
+		{"p4jenkins/1.10.11 (P4Java/2019.1/1858733)", appInfo{"p4jenkins", "", "1.10.11", "", "jenkins"}},
+		{"MyTool (P4Python/2019.1/1858733)", appInfo{"MyTool", "", "2019.1", "1858733", "MyTool"}},
+		{"P4.NET API/NTX64/2019.1/1796703", appInfo{"P4.NET API", "NTX64", "2019.1", "1796703", "P4.NET"}},
+		{"p4x-tool/1.0", appInfo{"p4x-tool", "", "1.0", "", "p4x-tool"}},
+	}
+	for _, tt := range tests {
+		if got := parseApp(tt.app); got != tt.want {
+			t.Errorf("parseApp(%q) = %+v, want %+v", tt.app, got, tt.want)
+		}
+	}
+}
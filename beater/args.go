@@ -0,0 +1,180 @@
+package beater
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+)
+
+// Maximum number of paths/changelists published from the args of a command
+const maxArgValues = 100
+
+// argSpec describes the options of a command. Options listed in values take a value
+// (e.g. -m 10 or -m10), which is published as p4.arg.<field> - or not at all if the field
+// is empty. Option "rest" takes the rest of the args, e.g. submit -d description.
+type argSpec struct {
+	values map[string]string
+	rest   string
+	// numeric args are changelists rather than paths (describe)
+	changes bool
+}
+
+// Decoders for the most common commands. Commands not listed just have p4.args.
+var argSpecs = map[string]argSpec{
+	"sync":      {values: map[string]string{"-m": "max"}},
+	"submit":    {values: map[string]string{"-c": "change", "-e": "change", "-f": "", "--noretransfer": ""}, rest: "-d"},
+	"changes":   {values: map[string]string{"-m": "max", "-s": "status", "-u": "user", "-c": "client", "-e": "change", "-t": ""}},
+	"files":     {values: map[string]string{"-m": "max"}},
+	"fstat":     {values: map[string]string{"-m": "max", "-c": "change", "-e": "change", "-F": "", "-T": "", "-A": ""}},
+	"integrate": {values: map[string]string{"-m": "max", "-c": "change", "-b": "branch", "-S": "stream", "-P": ""}},
+	"describe":  {values: map[string]string{"-m": "max"}, changes: true},
+	"print":     {values: map[string]string{"-m": "max", "-o": ""}},
+	"filelog":   {values: map[string]string{"-m": "max", "-c": "change"}},
+	"shelve":    {values: map[string]string{"-c": "change", "-a": ""}},
+}
+
+// Matches changelist revision specifiers, e.g. @1234, @=1234, @>1234
+var reArgChange = regexp.MustCompile(`^@[=<>]?(\d+)$`)
+
+// commandArgs are the args of a command decoded according to its argSpec
+type commandArgs struct {
+	flags    []string
+	paths    []string
+	changes  []int64
+	max      int64
+	wildcard bool
+	options  map[string]string
+}
+
+// parseArgs decodes the args for the command, returning false if it isn't a command we decode
+func parseArgs(cmd, args string) (*commandArgs, bool) {
+	cmd = strings.TrimPrefix(cmd, "user-")
+	if cmd == "integ" {
+		cmd = "integrate"
+	}
+	spec, ok := argSpecs[cmd]
+	if !ok {
+		return nil, false
+	}
+	a := &commandArgs{options: make(map[string]string)}
+	tokens := strings.Fields(args)
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i]
+		if t == spec.rest {
+			a.flags = append(a.flags, t)
+			break
+		}
+		if strings.HasPrefix(t, "-") && len(t) > 1 {
+			flag, value, hasValue := splitArgOption(t, spec.values)
+			a.flags = append(a.flags, flag)
+			if _, ok := spec.values[flag]; ok && !hasValue && i+1 < len(tokens) {
+				i++
+				value, hasValue = tokens[i], true
+			}
+			if hasValue {
+				a.setOption(spec.values[flag], value)
+			}
+			continue
+		}
+		if spec.changes {
+			if n, err := strconv.ParseInt(t, 10, 64); err == nil {
+				a.addChange(n)
+				continue
+			}
+		}
+		a.addPath(t)
+	}
+	return a, true
+}
+
+// splitArgOption splits an option from an attached value, e.g. -m10, --parallel=4
+func splitArgOption(t string, values map[string]string) (string, string, bool) {
+	if strings.HasPrefix(t, "--") {
+		if i := strings.Index(t, "="); i >= 0 {
+			return t[:i], t[i+1:], true
+		}
+		return t, "", false
+	}
+	if _, ok := values[t[:2]]; ok && len(t) > 2 {
+		return t[:2], t[2:], true
+	}
+	return t, "", false
+}
+
+func (a *commandArgs) setOption(field, value string) {
+	switch field {
+	case "":
+	case "max":
+		if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+			a.max = n
+		}
+	case "change":
+		if n, err := strconv.ParseInt(strings.TrimPrefix(value, "@"), 10, 64); err == nil {
+			a.addChange(n)
+		}
+	default:
+		a.options[field] = value
+	}
+}
+
+func (a *commandArgs) addChange(n int64) {
+	for _, c := range a.changes {
+		if c == n {
+			return
+		}
+	}
+	if len(a.changes) < maxArgValues {
+		a.changes = append(a.changes, n)
+	}
+}
+
+// addPath adds a file argument, taking any changelists from its revision specifiers,
+// e.g. //depot/main/...@1234,@1240
+func (a *commandArgs) addPath(t string) {
+	path := t
+	if i := strings.IndexAny(t, "@#"); i >= 0 {
+		path = t[:i]
+		for _, rev := range strings.Split(t[i:], ",") {
+			if m := reArgChange.FindStringSubmatch(rev); len(m) > 0 {
+				if n, err := strconv.ParseInt(m[1], 10, 64); err == nil {
+					a.addChange(n)
+				}
+			}
+		}
+	}
+	if path == "" {
+		return
+	}
+	if strings.Contains(path, "...") || strings.Contains(path, "*") {
+		a.wildcard = true
+	}
+	if len(a.paths) < maxArgValues {
+		a.paths = append(a.paths, path)
+	}
+}
+
+// setArgFields adds the decoded args of the command to the event
+func setArgFields(event *beat.Event, cmd, args string) {
+	a, ok := parseArgs(cmd, args)
+	if !ok {
+		return
+	}
+	if len(a.flags) > 0 {
+		event.Fields["p4.arg.flags"] = a.flags
+	}
+	if len(a.paths) > 0 {
+		event.Fields["p4.arg.paths"] = a.paths
+		event.Fields["p4.arg.wildcard"] = a.wildcard
+	}
+	if len(a.changes) > 0 {
+		event.Fields["p4.arg.changes"] = a.changes
+	}
+	if a.max > 0 {
+		event.Fields["p4.arg.max"] = a.max
+	}
+	for k, v := range a.options {
+		event.Fields["p4.arg."+k] = v
+	}
+}
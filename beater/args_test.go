@@ -0,0 +1,54 @@
+//go:build !integration
+// +build !integration
+
+package beater
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseArgs(t *testing.T) {
+	tests := []struct {
+		cmd  string
+		args string
+		want *commandArgs
+	}{
+		{"user-info", "", nil},
+		{"user-sync", "-m10 //depot/main/...@1234",
+			&commandArgs{flags: []string{"-m"}, paths: []string{"//depot/main/..."}, changes: []int64{1234}, max: 10, wildcard: true}},
+		{"user-sync", "-m 10 //depot/main/a.c#head",
+			&commandArgs{flags: []string{"-m"}, paths: []string{"//depot/main/a.c"}, max: 10}},
+		{"user-files", "//depot/main/...@>10,@20",
+			&commandArgs{paths: []string{"//depot/main/..."}, changes: []int64{10, 20}, wildcard: true}},
+		{"user-submit", "-c 42",
+			&commandArgs{flags: []string{"-c"}, changes: []int64{42}}},
+		{"user-submit", "-d fix -c 5 //depot/...",
+			&commandArgs{flags: []string{"-d"}}},
+		{"user-changes", "-s submitted -u fred -m1 //depot/...",
+			&commandArgs{flags: []string{"-s", "-u", "-m"}, paths: []string{"//depot/..."}, max: 1, wildcard: true,
+				options: map[string]string{"status": "submitted", "user": "fred"}}},
+		{"user-integ", "-b main-dev -c 3 //depot/dev/...",
+			&commandArgs{flags: []string{"-b", "-c"}, paths: []string{"//depot/dev/..."}, changes: []int64{3}, wildcard: true,
+				options: map[string]string{"branch": "main-dev"}}},
+		{"user-describe", "-s 123 124 123",
+			&commandArgs{flags: []string{"-s"}, changes: []int64{123, 124}}},
+		{"user-fstat", "--parallel=4 -Olhp //depot/a.c",
+			&commandArgs{flags: []string{"--parallel", "-Olhp"}, paths: []string{"//depot/a.c"}}},
+	}
+	for _, tt := range tests {
+		got, ok := parseArgs(tt.cmd, tt.args)
+		if tt.want == nil {
+			if ok {
+				t.Errorf("parseArgs(%q, %q) decoded args for a command not decoded", tt.cmd, tt.args)
+			}
+			continue
+		}
+		if tt.want.options == nil {
+			tt.want.options = map[string]string{}
+		}
+		if !ok || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseArgs(%q, %q) = %+v, want %+v", tt.cmd, tt.args, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,306 @@
+package beater
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/rcowham/p4dbeat/config"
+)
+
+// Matches a line of the P4AUDIT log, e.g.
+//
+//	2020/05/11 16:12:47 fred@fred_ws 10.0.0.1 sync //depot/main/file.txt#3
+//
+// The IP is "proxy/client" for accesses via a proxy or broker.
+var reAuditLine = regexp.MustCompile(`^(\d\d\d\d/\d\d/\d\d \d\d:\d\d:\d\d) ([^@ ]+)@(\S+) (\S+) (\S+) (.*?)(?:#(\d+|none))?$`)
+
+// auditRecord is a single file access from the audit log
+type auditRecord struct {
+	time      time.Time
+	user      string
+	client    string
+	ip        string
+	proxyIP   string
+	action    string
+	depotPath string
+	rev       int64
+	lineNo    int64
+	offset    int64 // offset of the start of the line
+	line      string
+}
+
+func parseAuditLine(line string) (*auditRecord, error) {
+	m := reAuditLine.FindStringSubmatch(strings.TrimRight(line, "\r"))
+	if len(m) == 0 {
+		return nil, fmt.Errorf("unrecognised audit line")
+	}
+	t, err := time.Parse(p4timeformat, m[1])
+	if err != nil {
+		return nil, err
+	}
+	rec := &auditRecord{
+		time:      t,
+		user:      m[2],
+		client:    m[3],
+		ip:        m[4],
+		action:    m[5],
+		depotPath: m[6],
+		rev:       toInt64(m[7]),
+		line:      line,
+	}
+	if m[7] == "none" {
+		rec.rev = 0
+	}
+	if ips := strings.Split(rec.ip, "/"); len(ips) > 1 {
+		rec.proxyIP, rec.ip = ips[0], ips[1]
+	}
+	return rec, nil
+}
+
+type auditKey struct {
+	user, client, ip, action string
+}
+
+// auditGroup is a run of accesses for the same user/client/ip/action being rolled up.
+// Records are buffered until the group reaches the threshold - if it doesn't they are
+// published individually.
+type auditGroup struct {
+	records []*auditRecord
+	count   int
+	first   *auditRecord
+	last    *auditRecord
+	prefix  string    // common directory of all paths
+	updated time.Time // when the last record was read, to flush idle groups
+}
+
+// auditRollup rolls up bulk file accesses for an input into summary events
+type auditRollup struct {
+	actions   map[string]bool
+	threshold int
+	window    time.Duration
+	groups    map[auditKey]*auditGroup
+}
+
+// newAuditRollup returns nil if rollups are not enabled
+func newAuditRollup(cfg config.AuditRollup) *auditRollup {
+	if !cfg.Enabled {
+		return nil
+	}
+	r := &auditRollup{
+		actions:   make(map[string]bool),
+		threshold: cfg.Threshold,
+		window:    cfg.Window,
+		groups:    make(map[auditKey]*auditGroup),
+	}
+	if r.threshold <= 0 {
+		r.threshold = 100
+	}
+	if r.window <= 0 {
+		r.window = 5 * time.Second
+	}
+	actions := cfg.Actions
+	if len(actions) == 0 {
+		actions = []string{"sync"}
+	}
+	for _, a := range actions {
+		r.actions[a] = true
+	}
+	return r
+}
+
+// add adds the record to its group, returning false if the action is not rolled up.
+// Groups which ended more than the window before this record are returned to be published.
+func (r *auditRollup) add(rec *auditRecord) (bool, []*auditGroup) {
+	ended := r.ended(func(g *auditGroup) bool { return rec.time.Sub(g.last.time) > r.window })
+	if !r.actions[rec.action] {
+		return false, ended
+	}
+	key := auditKey{rec.user, rec.client, rec.ip, rec.action}
+	g, ok := r.groups[key]
+	if !ok {
+		g = &auditGroup{first: rec, prefix: pathDir(rec.depotPath)}
+		r.groups[key] = g
+	}
+	g.count++
+	g.last = rec
+	g.updated = time.Now()
+	g.prefix = commonDir(g.prefix, rec.depotPath)
+	if g.count < r.threshold {
+		g.records = append(g.records, rec)
+	} else {
+		g.records = nil
+	}
+	return true, ended
+}
+
+// idle returns groups which haven't had a record for the window (e.g. the log is quiet)
+func (r *auditRollup) idle() []*auditGroup {
+	return r.ended(func(g *auditGroup) bool { return time.Since(g.updated) > r.window })
+}
+
+// all returns all groups, e.g. when the log file is replaced
+func (r *auditRollup) all() []*auditGroup {
+	return r.ended(func(g *auditGroup) bool { return true })
+}
+
+func (r *auditRollup) ended(f func(g *auditGroup) bool) []*auditGroup {
+	var ended []*auditGroup
+	for k, g := range r.groups {
+		if f(g) {
+			ended = append(ended, g)
+			delete(r.groups, k)
+		}
+	}
+	return ended
+}
+
+// oldest returns the earliest record still held in a group, for the resume position
+func (r *auditRollup) oldest() *auditRecord {
+	var oldest *auditRecord
+	for _, g := range r.groups {
+		if oldest == nil || g.first.offset < oldest.offset {
+			oldest = g.first
+		}
+	}
+	return oldest
+}
+
+// pathDir returns the directory of the depot path including the trailing slash
+func pathDir(path string) string {
+	if i := strings.LastIndex(path, "/"); i >= 0 {
+		return path[:i+1]
+	}
+	return path
+}
+
+// commonDir returns the longest common directory of dir and path
+func commonDir(dir, path string) string {
+	if strings.HasPrefix(path, dir) {
+		return dir
+	}
+	n := 0
+	for n < len(dir) && n < len(path) && dir[n] == path[n] {
+		n++
+	}
+	return pathDir(dir[:n])
+}
+
+// auditLine parses and publishes a line from the audit log, rolling up bulk accesses if configured
+func (bt *P4dbeat) auditLine(in *logInput, line string, pos *logPosition, st *fileState) {
+	lineNo, offset := pos.lineNo, pos.offset
+	pos.addLine(line)
+	if strings.TrimSpace(line) == "" {
+		return
+	}
+	rec, err := parseAuditLine(line)
+	if err != nil {
+		bt.log.Warnf("Invalid audit record at line %d of '%s': %v", lineNo, in.path, err)
+		return
+	}
+	rec.lineNo, rec.offset = lineNo, offset
+	rec.time = in.clock.convert(rec.time, time.Time{})
+	in.clock.observe(rec.time)
+	if in.audit == nil {
+		bt.publishAudit(in, rec, bt.auditState(in, pos, st))
+		return
+	}
+	rolled, ended := in.audit.add(rec)
+	bt.publishAuditGroups(in, ended, pos, st)
+	if !rolled {
+		bt.publishAudit(in, rec, bt.auditState(in, pos, st))
+	}
+}
+
+// flushAudit publishes rolled up groups which are idle, or all of them if all is set
+func (bt *P4dbeat) flushAudit(in *logInput, pos *logPosition, st *fileState, all bool) {
+	if in.audit == nil {
+		return
+	}
+	if all {
+		bt.publishAuditGroups(in, in.audit.all(), pos, st)
+	} else {
+		bt.publishAuditGroups(in, in.audit.idle(), pos, st)
+	}
+}
+
+// auditState returns the offset update for events being published. We resume from the first
+// record of any group still being rolled up. Records re-read after a restart get the same
+// document IDs so aren't duplicated.
+func (bt *P4dbeat) auditState(in *logInput, pos *logPosition, st *fileState) offsetUpdate {
+	pos.update(st)
+	if in.audit != nil {
+		if rec := in.audit.oldest(); rec != nil {
+			st.Offset, st.LineNo = rec.offset, rec.lineNo
+		}
+	}
+	return offsetUpdate{key: in.stateKey, state: *st}
+}
+
+func (bt *P4dbeat) publishAuditGroups(in *logInput, groups []*auditGroup, pos *logPosition, st *fileState) {
+	for _, g := range groups {
+		if g.count < in.audit.threshold {
+			for _, rec := range g.records {
+				bt.publishAudit(in, rec, bt.auditState(in, pos, st))
+			}
+			continue
+		}
+		bt.publishAuditSummary(in, g, bt.auditState(in, pos, st))
+	}
+}
+
+func (bt *P4dbeat) auditEvent(in *logInput, rec *auditRecord, timestamp time.Time, private interface{}) beat.Event {
+	event := beat.Event{
+		Timestamp: timestamp,
+		Private:   private,
+		Fields: common.MapStr{
+			"type":            bt.name,
+			"p4.line_no":      rec.lineNo,
+			"p4.audit.user":   rec.user,
+			"p4.audit.client": rec.client,
+			"p4.audit.action": rec.action,
+		},
+	}
+	if rec.ip != "" {
+		event.Fields["p4.audit.ip"] = rec.ip
+	}
+	if rec.proxyIP != "" {
+		event.Fields["p4.audit.proxy_ip"] = rec.proxyIP
+	}
+	if bt.privacy != nil {
+		bt.privacy.audit(&event)
+	}
+	for k, v := range in.fields {
+		event.Fields[k] = v
+	}
+	return event
+}
+
+// publishAudit publishes a single file access
+func (bt *P4dbeat) publishAudit(in *logInput, rec *auditRecord, private interface{}) {
+	event := bt.auditEvent(in, rec, bt.eventTimestamp(rec.time, rec.time), private)
+	event.Fields["p4.audit.depot_path"] = rec.depotPath
+	event.Fields["p4.audit.rev"] = rec.rev
+	if bt.config.DeterministicID {
+		event.SetID(structuredID(in.serverID(), "audit", fmt.Sprintf("%d|%s", rec.lineNo, rec.line)))
+	}
+	bt.publish(event)
+}
+
+// publishAuditSummary publishes a single event for a bulk access. The depot path is the
+// common directory of all the files accessed, e.g. //depot/main/...
+func (bt *P4dbeat) publishAuditSummary(in *logInput, g *auditGroup, private interface{}) {
+	event := bt.auditEvent(in, g.first, bt.eventTimestamp(g.first.time, g.last.time), private)
+	event.Fields["p4.audit.depot_path"] = g.prefix + "..."
+	event.Fields["p4.audit.summary"] = true
+	event.Fields["p4.audit.count"] = g.count
+	event.Fields["p4.audit.start_time"] = g.first.time
+	event.Fields["p4.audit.end_time"] = g.last.time
+	if bt.config.DeterministicID {
+		event.SetID(structuredID(in.serverID(), "audit_summary", fmt.Sprintf("%d|%s", g.first.lineNo, g.first.line)))
+	}
+	bt.publish(event)
+}
@@ -0,0 +1,116 @@
+//go:build !integration
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rcowham/p4dbeat/config"
+)
+
+func TestParseAuditLine(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want *auditRecord // nil if invalid
+	}{
+		{"sync", "2020/05/11 16:12:47 fred@fred_ws 10.0.0.1 sync //depot/main/file.txt#3",
+			&auditRecord{user: "fred", client: "fred_ws", ip: "10.0.0.1", action: "sync", depotPath: "//depot/main/file.txt", rev: 3}},
+		{"via proxy", "2020/05/11 16:12:47 fred@fred_ws 10.0.0.9/10.0.0.1 sync //depot/main/file.txt#3",
+			&auditRecord{user: "fred", client: "fred_ws", ip: "10.0.0.1", proxyIP: "10.0.0.9", action: "sync", depotPath: "//depot/main/file.txt", rev: 3}},
+		{"deleted rev", "2020/05/11 16:12:47 fred@fred_ws 10.0.0.1 sync //depot/main/file.txt#none",
+			&auditRecord{user: "fred", client: "fred_ws", ip: "10.0.0.1", action: "sync", depotPath: "//depot/main/file.txt"}},
+		{"no rev", "2020/05/11 16:12:47 fred@fred_ws 10.0.0.1 diff //depot/main/file.txt",
+			&auditRecord{user: "fred", client: "fred_ws", ip: "10.0.0.1", action: "diff", depotPath: "//depot/main/file.txt"}},
+		{"path with spaces and #", "2020/05/11 16:12:47 fred@fred_ws 10.0.0.1 print //depot/main/my file#1.txt#12",
+			&auditRecord{user: "fred", client: "fred_ws", ip: "10.0.0.1", action: "print", depotPath: "//depot/main/my file#1.txt", rev: 12}},
+		{"CRLF", "2020/05/11 16:12:47 fred@fred_ws 10.0.0.1 sync //depot/main/file.txt#3\r",
+			&auditRecord{user: "fred", client: "fred_ws", ip: "10.0.0.1", action: "sync", depotPath: "//depot/main/file.txt", rev: 3}},
+		{"IPv6", "2020/05/11 16:12:47 fred@fred_ws ::1 sync //depot/main/file.txt#3",
+			&auditRecord{user: "fred", client: "fred_ws", ip: "::1", action: "sync", depotPath: "//depot/main/file.txt", rev: 3}},
+		{"no client", "2020/05/11 16:12:47 fred 10.0.0.1 sync //depot/main/file.txt#3", nil},
+		{"invalid time", "2020/05/11 25:12:47 fred@fred_ws 10.0.0.1 sync //depot/main/file.txt#3", nil},
+		{"not audit", "Perforce server info:", nil},
+	}
+	wantTime := time.Date(2020, 5, 11, 16, 12, 47, 0, time.UTC)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, err := parseAuditLine(tt.line)
+			if tt.want == nil {
+				if err == nil {
+					t.Errorf("parsed %+v, want error", rec)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !rec.time.Equal(wantTime) || rec.line != tt.line {
+				t.Errorf("time %v line %q, want %v %q", rec.time, rec.line, wantTime, tt.line)
+			}
+			rec.time, rec.line = time.Time{}, ""
+			if *rec != *tt.want {
+				t.Errorf("parsed %+v, want %+v", rec, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommonDir(t *testing.T) {
+	tests := []struct {
+		dir, path, want string
+	}{
+		{"//depot/main/", "//depot/main/a.c", "//depot/main/"},
+		{"//depot/main/", "//depot/main/src/a.c", "//depot/main/"},
+		{"//depot/main/src/", "//depot/main/doc/a.txt", "//depot/main/"},
+		{"//depot/main/", "//depot/mainline/a.c", "//depot/"}, // whole directories only
+		{"//depot/main/", "//other/a.c", "//"},
+		{"//depot/main/src/", "//depot/main/src", "//depot/main/"},
+	}
+	for _, tt := range tests {
+		if got := commonDir(tt.dir, tt.path); got != tt.want {
+			t.Errorf("commonDir(%q, %q) = %q, want %q", tt.dir, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestAuditRollup(t *testing.T) {
+	lines := []string{
+		"2020/05/11 16:12:47 fred@fred_ws 10.0.0.1 sync //depot/main/src/a.c#3",
+		"2020/05/11 16:12:47 bill@bill_ws 10.0.0.2 sync //depot/main/b.c#1",
+		"2020/05/11 16:12:47 fred@fred_ws 10.0.0.1 sync //depot/main/src/b.c#1",
+		"2020/05/11 16:12:48 fred@fred_ws 10.0.0.1 print //depot/main/src/b.c#1",
+		"2020/05/11 16:12:48 fred@fred_ws 10.0.0.1 sync //depot/main/doc/c.txt#2",
+		"2020/05/11 16:12:59 fred@fred_ws 10.0.0.1 sync //depot/rel/a.c#1", // after the window
+	}
+	bt := newTestBeat()
+	in := bt.newLogInput(config.Input{Path: "audit.log", Parser: config.ParserP4Audit,
+		AuditRollup: config.AuditRollup{Enabled: true, Threshold: 3, Window: 5 * time.Second}}, "audit.log", "audit.log")
+	pos := newLogPosition(0, 1)
+	var st fileState
+	for _, line := range lines {
+		bt.auditLine(in, line, pos, &st)
+	}
+	bt.flushAudit(in, pos, &st, true)
+	var summaries, files []string
+	for _, e := range bt.published() {
+		path := e.Fields["p4.audit.depot_path"].(string)
+		if e.Fields["p4.audit.summary"] == true {
+			summaries = append(summaries, path)
+			if e.Fields["p4.audit.count"] != 3 || e.Fields["p4.audit.user"] != "fred" {
+				t.Errorf("summary %v, want 3 files for fred", e.Fields)
+			}
+		} else {
+			files = append(files, path)
+		}
+	}
+	if len(summaries) != 1 || summaries[0] != "//depot/main/..." {
+		t.Errorf("summaries %v, want //depot/main/...", summaries)
+	}
+	// print isn't rolled up, and bill's and the later sync are under the threshold
+	if len(files) != 3 {
+		t.Errorf("files published individually %v, want 3", files)
+	}
+}
@@ -0,0 +1,227 @@
+package beater
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	p4dlog "github.com/rcowham/go-libp4dlog"
+	"github.com/rcowham/p4dbeat/config"
+)
+
+// lockCommand is the summary of a command which held or waited for a table lock
+type lockCommand struct {
+	pid         int64
+	processKey  string
+	lineNo      int64
+	user        string
+	cmd         string
+	start       time.Time
+	end         time.Time
+	readHeldMS  int64
+	writeHeldMS int64
+}
+
+type tableKey struct {
+	serverID string
+	table    string
+}
+
+// lockWait is a command which waited for a table lock for longer than the threshold
+type lockWait struct {
+	key     tableKey
+	fields  common.MapStr // static fields of the waiter's input
+	waiter  lockCommand
+	lock    string // read or write
+	waitMS  int64
+	arrived time.Time
+}
+
+// contention is a lock wait with its likely blockers, most likely first
+type contention struct {
+	lockWait
+	blockers []lockCommand
+}
+
+// contentionAnalyser keeps a time-indexed view of the commands holding locks on each table, and
+// matches commands which waited for a lock to the commands which held a conflicting lock at the
+// same time. A write lock conflicts with any lock, a read lock only with a write lock.
+// The log only gives lock wait/held totals per command, so the holders are those whose
+// run overlapped with the waiter, ranked by how long they held the lock.
+// Blockers may be output after the waiter, so waits are analysed after a delay - once commands
+// ending that long after the waiter have been seen, or (when tailing) that long after it arrived.
+// Commands are added from each input's goroutine.
+type contentionAnalyser struct {
+	mu        sync.Mutex
+	cfg       config.Contention
+	holders   map[tableKey][]lockCommand
+	waits     []lockWait
+	latest    time.Time // latest command end time seen
+	importing bool      // only log time is used, as imports run much faster than real time
+}
+
+func newContentionAnalyser(cfg config.Contention) *contentionAnalyser {
+	return &contentionAnalyser{
+		cfg:     cfg,
+		holders: make(map[tableKey][]lockCommand),
+	}
+}
+
+// add records the locks held and waited for by the command
+func (a *contentionAnalyser) add(in *logInput, command *p4dlog.Command) {
+	lc := lockCommand{
+		pid:        command.Pid,
+		processKey: command.ProcessKey,
+		lineNo:     command.LineNo,
+		user:       command.User,
+		cmd:        command.Cmd,
+		start:      command.StartTime,
+		end:        command.EndTime,
+	}
+	if lc.end.IsZero() || lc.end.Before(lc.start) {
+		lc.end = lc.start.Add(time.Duration(command.CompletedLapse * float32(time.Second)))
+	}
+	threshold := a.cfg.Threshold.Milliseconds()
+	serverID := in.serverID()
+	now := time.Now()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if lc.end.After(a.latest) {
+		a.latest = lc.end
+	}
+	for _, t := range command.Tables {
+		key := tableKey{serverID: serverID, table: strings.ToLower(t.TableName)}
+		if t.TotalReadHeld > 0 || t.TotalWriteHeld > 0 {
+			h := lc
+			h.readHeldMS, h.writeHeldMS = t.TotalReadHeld, t.TotalWriteHeld
+			a.holders[key] = append(a.holders[key], h)
+		}
+		if t.TotalReadWait >= threshold && t.TotalReadWait > 0 {
+			a.waits = append(a.waits, lockWait{key: key, fields: in.fields, waiter: lc, lock: "read", waitMS: t.TotalReadWait, arrived: now})
+		}
+		if t.TotalWriteWait >= threshold && t.TotalWriteWait > 0 {
+			a.waits = append(a.waits, lockWait{key: key, fields: in.fields, waiter: lc, lock: "write", waitMS: t.TotalWriteWait, arrived: now})
+		}
+	}
+}
+
+// heldMS returns how long the holder held a lock conflicting with the lock waited for
+func (h *lockCommand) heldMS(lock string) int64 {
+	if lock == "read" {
+		return h.writeHeldMS
+	}
+	if h.writeHeldMS > h.readHeldMS {
+		return h.writeHeldMS
+	}
+	return h.readHeldMS
+}
+
+// ready returns true if blockers of the wait which are output after it should have been seen
+func (a *contentionAnalyser) ready(w *lockWait) bool {
+	if a.latest.Sub(w.waiter.end) >= a.cfg.Delay {
+		return true
+	}
+	return !a.importing && time.Since(w.arrived) >= a.cfg.Delay
+}
+
+// analyse returns the waits which have been held for the delay, or all of them if all is set,
+// with their likely blockers. Holders older than the retention period are then dropped.
+func (a *contentionAnalyser) analyse(all bool) []contention {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	results := make([]contention, 0)
+	pending := a.waits[:0]
+	for _, w := range a.waits {
+		if !all && !a.ready(&w) {
+			pending = append(pending, w)
+			continue
+		}
+		c := contention{lockWait: w}
+		for _, h := range a.holders[w.key] {
+			if h.processKey == w.waiter.processKey && h.lineNo == w.waiter.lineNo {
+				continue
+			}
+			if h.heldMS(w.lock) > 0 && h.start.Before(w.waiter.end) && h.end.After(w.waiter.start) {
+				c.blockers = append(c.blockers, h)
+			}
+		}
+		sort.SliceStable(c.blockers, func(i, j int) bool {
+			return c.blockers[i].heldMS(w.lock) > c.blockers[j].heldMS(w.lock)
+		})
+		if len(c.blockers) > a.cfg.MaxBlockers {
+			c.blockers = c.blockers[:a.cfg.MaxBlockers]
+		}
+		results = append(results, c)
+	}
+	a.waits = pending
+
+	oldest := a.latest.Add(-a.cfg.Retention)
+	for key, holders := range a.holders {
+		kept := holders[:0]
+		for _, h := range holders {
+			if h.end.After(oldest) {
+				kept = append(kept, h)
+			}
+		}
+		if len(kept) == 0 {
+			delete(a.holders, key)
+		} else {
+			a.holders[key] = kept
+		}
+	}
+	return results
+}
+
+// publishContention publishes a p4.contention event for each lock wait, naming the likely blockers
+func (bt *P4dbeat) publishContention(contentions []contention) {
+	for _, c := range contentions {
+		blockers := make([]common.MapStr, 0, len(c.blockers))
+		for _, b := range c.blockers {
+			lock := "read"
+			if b.writeHeldMS > 0 {
+				lock = "write"
+			}
+			blockers = append(blockers, common.MapStr{
+				"pid":         b.pid,
+				"user":        b.user,
+				"cmd":         b.cmd,
+				"process_key": b.processKey,
+				"lock":        lock,
+				"held_sec":    float64(b.heldMS(c.lock)) / 1000.0,
+				"start_time":  b.start,
+				"end_time":    b.end,
+			})
+		}
+		event := beat.Event{
+			Timestamp: bt.eventTimestamp(c.waiter.start, c.waiter.end),
+			Fields: common.MapStr{
+				"type":                        bt.name,
+				"p4.process_key":              c.waiter.processKey,
+				"p4.cmd":                      c.waiter.cmd,
+				"p4.pid":                      c.waiter.pid,
+				"p4.user":                     c.waiter.user,
+				"p4.line_no":                  c.waiter.lineNo,
+				"p4.start_time":               c.waiter.start,
+				"p4.end_time":                 c.waiter.end,
+				"p4.contention.table":         c.key.table,
+				"p4.contention.lock":          c.lock,
+				"p4.contention.wait_sec":      float64(c.waitMS) / 1000.0,
+				"p4.contention.blocker_count": len(blockers),
+				"p4.contention.blockers":      blockers,
+			},
+		}
+		for k, v := range c.fields {
+			event.Fields[k] = v
+		}
+		if bt.config.DeterministicID {
+			event.SetID(structuredID(c.key.serverID, "contention", fmt.Sprintf("%s|%d|%s|%s",
+				c.waiter.processKey, c.waiter.lineNo, c.key.table, c.lock)))
+		}
+		bt.publish(event)
+	}
+}
@@ -0,0 +1,128 @@
+//go:build !integration
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+	"time"
+
+	p4dlog "github.com/rcowham/go-libp4dlog"
+	"github.com/rcowham/p4dbeat/config"
+)
+
+// lockTable is the lock times in ms of a command on the rev table
+type lockTable struct {
+	readWait, writeWait, readHeld, writeHeld int64
+}
+
+func lockingCommand(pid int64, start time.Time, sec int, t lockTable) *p4dlog.Command {
+	return &p4dlog.Command{Cmd: "user-submit", User: "fred", Pid: pid, LineNo: pid,
+		ProcessKey: string(rune('a' + pid)), StartTime: start, EndTime: start.Add(time.Duration(sec) * time.Second),
+		Tables: map[string]*p4dlog.Table{"rev": {TableName: "rev", TotalReadWait: t.readWait, TotalWriteWait: t.writeWait,
+			TotalReadHeld: t.readHeld, TotalWriteHeld: t.writeHeld}}}
+}
+
+func TestContentionBlockers(t *testing.T) {
+	start := time.Date(2018, 9, 2, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		waiter   lockTable
+		holders  []lockTable // pids 2.., all running alongside the waiter
+		wantLock string
+		want     []int64 // blocker pids
+	}{
+		{"ranked by held time", lockTable{writeWait: 5000}, []lockTable{{writeHeld: 1000}, {writeHeld: 4000}, {readHeld: 2000}},
+			"write", []int64{3, 4, 2}},
+		{"read waits for writers only", lockTable{readWait: 5000}, []lockTable{{readHeld: 4000}, {writeHeld: 1000}},
+			"read", []int64{3}},
+		{"write waits for readers", lockTable{writeWait: 5000}, []lockTable{{readHeld: 4000}},
+			"write", []int64{2}},
+		{"max blockers", lockTable{writeWait: 5000}, []lockTable{{writeHeld: 1}, {writeHeld: 2}, {writeHeld: 3}, {writeHeld: 4}},
+			"write", []int64{5, 4, 3}},
+		{"no blockers", lockTable{writeWait: 5000}, nil, "write", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newContentionAnalyser(config.DefaultConfig.Contention)
+			in := &logInput{}
+			a.add(in, lockingCommand(1, start, 10, tt.waiter))
+			for i, h := range tt.holders {
+				a.add(in, lockingCommand(int64(i+2), start.Add(time.Second), 5, h))
+			}
+			got := a.analyse(true)
+			if len(got) != 1 {
+				t.Fatalf("%d waits, want 1", len(got))
+			}
+			if got[0].waiter.pid != 1 || got[0].lock != tt.wantLock {
+				t.Errorf("wait by %d for %s lock, want 1 %s", got[0].waiter.pid, got[0].lock, tt.wantLock)
+			}
+			var pids []int64
+			for _, b := range got[0].blockers {
+				pids = append(pids, b.pid)
+			}
+			if len(pids) != len(tt.want) {
+				t.Fatalf("blockers %v, want %v", pids, tt.want)
+			}
+			for i := range pids {
+				if pids[i] != tt.want[i] {
+					t.Errorf("blockers %v, want %v", pids, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestContentionOverlap(t *testing.T) {
+	// Only commands running at the same time as the waiter are blockers, and short waits are ignored
+	start := time.Date(2018, 9, 2, 10, 0, 0, 0, time.UTC)
+	a := newContentionAnalyser(config.DefaultConfig.Contention)
+	in := &logInput{}
+	a.add(in, lockingCommand(1, start, 10, lockTable{writeWait: 5000}))
+	a.add(in, lockingCommand(2, start.Add(-time.Minute), 5, lockTable{writeHeld: 5000}))
+	a.add(in, lockingCommand(3, start.Add(11*time.Second), 5, lockTable{writeHeld: 5000}))
+	a.add(in, lockingCommand(4, start.Add(5*time.Second), 2, lockTable{writeWait: 500, writeHeld: 100}))
+	got := a.analyse(true)
+	if len(got) != 1 {
+		t.Fatalf("%d waits, want 1", len(got))
+	}
+	if len(got[0].blockers) != 1 || got[0].blockers[0].pid != 4 {
+		t.Errorf("blockers %+v, want just 4", got[0].blockers)
+	}
+}
+
+func TestContentionDelay(t *testing.T) {
+	// When importing, waits are analysed once commands ending the delay after the waiter are seen,
+	// so blockers output after the waiter are found
+	start := time.Date(2018, 9, 2, 10, 0, 0, 0, time.UTC)
+	a := newContentionAnalyser(config.DefaultConfig.Contention)
+	a.importing = true
+	in := &logInput{}
+	a.add(in, lockingCommand(1, start, 10, lockTable{writeWait: 5000}))
+	if got := a.analyse(false); len(got) != 0 {
+		t.Fatalf("%d waits analysed before the delay", len(got))
+	}
+	a.add(in, lockingCommand(2, start, 12, lockTable{writeHeld: 5000}))
+	a.add(in, lockingCommand(3, start.Add(15*time.Second), 5, lockTable{}))
+	got := a.analyse(false)
+	if len(got) != 1 || len(got[0].blockers) != 1 || got[0].blockers[0].pid != 2 {
+		t.Fatalf("waits %+v, want 1 blocked by 2", got)
+	}
+	if len(a.analyse(true)) != 0 {
+		t.Error("wait analysed twice")
+	}
+}
+
+func TestContentionRetention(t *testing.T) {
+	start := time.Date(2018, 9, 2, 10, 0, 0, 0, time.UTC)
+	a := newContentionAnalyser(config.DefaultConfig.Contention)
+	in := &logInput{}
+	a.add(in, lockingCommand(1, start, 5, lockTable{writeHeld: 5000}))
+	a.add(in, lockingCommand(2, start.Add(time.Hour), 5, lockTable{writeHeld: 5000}))
+	a.analyse(true)
+	for _, holders := range a.holders {
+		if len(holders) != 1 || holders[0].pid != 2 {
+			t.Errorf("holders %+v, want just the latest", holders)
+		}
+	}
+}
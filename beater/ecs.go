@@ -0,0 +1,114 @@
+package beater
+
+import (
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/rcowham/p4dbeat/config"
+)
+
+// ecsField maps a p4 field to an ECS field. Fields which are kept are published under both
+// names even in ecs mode, as ECS has no exact equivalent.
+type ecsField struct {
+	p4      string
+	ecs     string
+	convert func(interface{}) interface{}
+	keep    bool
+}
+
+var ecsCommandFields = []ecsField{
+	{p4: "p4.user", ecs: "user.name"},
+	{p4: "p4.user_full_name", ecs: "user.full_name"},
+	{p4: "p4.user_email", ecs: "user.email"},
+	{p4: "p4.ip", ecs: "source.ip"},
+	{p4: "p4.geo.location", ecs: "source.geo.location"},
+	{p4: "p4.geo.country_iso_code", ecs: "source.geo.country_iso_code"},
+	{p4: "p4.geo.country_name", ecs: "source.geo.country_name"},
+	{p4: "p4.geo.continent_name", ecs: "source.geo.continent_name"},
+	{p4: "p4.geo.region_name", ecs: "source.geo.region_name"},
+	{p4: "p4.geo.city_name", ecs: "source.geo.city_name"},
+	{p4: "p4.pid", ecs: "process.pid"},
+	{p4: "p4.cmd", ecs: "event.action", keep: true},
+	{p4: "p4.start_time", ecs: "event.start"},
+	{p4: "p4.end_time", ecs: "event.end"},
+	{p4: "p4.completed_sec", ecs: "event.duration", convert: secToNanos},
+	{p4: "p4.cmd_error", ecs: "event.outcome", convert: errorOutcome},
+	{p4: "p4.app", ecs: "user_agent.original"},
+	{p4: "p4.app_product", ecs: "user_agent.name", keep: true},
+	{p4: "p4.app_version", ecs: "user_agent.version", keep: true},
+}
+
+// secToNanos converts seconds to nanoseconds for event.duration
+func secToNanos(v interface{}) interface{} {
+	f, ok := toFloat(v)
+	if !ok {
+		return v
+	}
+	return int64(f * 1e9)
+}
+
+func errorOutcome(v interface{}) interface{} {
+	if failed, ok := v.(bool); ok && failed {
+		return "failure"
+	}
+	return "success"
+}
+
+// Alert events also have the key fields of the command (see alertKeyFields). The severity
+// stays as p4.alert.severity, as ECS event.severity is numeric.
+var ecsAlertFields = append([]ecsField{
+	{p4: "p4.alert.rule", ecs: "rule.name"},
+	{p4: "p4.alert.description", ecs: "rule.description"},
+}, ecsCommandFields...)
+
+// ecsCommand returns the fields of a command event for the configured schema. The event
+// fields are left unchanged as alerts are checked against the p4 fields.
+// hostname is the host of the p4d server, if known, unless set by the static fields.
+func ecsCommand(schema, hostname string, fields common.MapStr) common.MapStr {
+	if schema == config.SchemaP4 {
+		return fields
+	}
+	out := ecsFields(schema, hostname, fields, ecsCommandFields)
+	out["event.kind"] = "event"
+	out["event.dataset"] = "p4d.command"
+	return out
+}
+
+// ecsAlert returns the fields of an alert event for the configured schema
+func ecsAlert(schema, hostname string, fields common.MapStr) common.MapStr {
+	if schema == config.SchemaP4 {
+		return fields
+	}
+	out := ecsFields(schema, hostname, fields, ecsAlertFields)
+	out["event.kind"] = "alert"
+	out["event.dataset"] = "p4d.alert"
+	return out
+}
+
+func ecsFields(schema, hostname string, fields common.MapStr, mapping []ecsField) common.MapStr {
+	out := make(common.MapStr, len(fields)+len(mapping)+6)
+	for k, v := range fields {
+		out[k] = v
+	}
+	for _, f := range mapping {
+		v, ok := fields[f.p4]
+		if !ok {
+			continue
+		}
+		if f.convert != nil {
+			v = f.convert(v)
+		}
+		out[f.ecs] = v
+		if schema == config.SchemaECS && !f.keep {
+			delete(out, f.p4)
+		}
+	}
+	if _, ok := out["host.hostname"]; !ok && hostname != "" {
+		out["host.hostname"] = hostname
+	}
+	out["event.category"] = []string{"process"}
+	out["event.module"] = "p4d"
+	out["process.name"] = "p4d"
+	if schema == config.SchemaECS {
+		delete(out, "type")
+	}
+	return out
+}
@@ -0,0 +1,126 @@
+//go:build !integration
+// +build !integration
+
+package beater
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/conditions"
+	p4dlog "github.com/rcowham/go-libp4dlog"
+	"github.com/rcowham/p4dbeat/config"
+)
+
+func TestECSCommand(t *testing.T) {
+	fields := common.MapStr{
+		"type":             "p4dbeat",
+		"p4.cmd":           "user-sync",
+		"p4.user":          "fred",
+		"p4.pid":           int64(1234),
+		"p4.completed_sec": 1.5,
+		"p4.cmd_error":     true,
+		"p4.workspace":     "fred_ws",
+	}
+	tests := []struct {
+		name     string
+		schema   string
+		hostname string
+		static   common.MapStr
+		want     common.MapStr // fields expected, nil for absent
+	}{
+		{"p4", config.SchemaP4, "p4d1", nil, common.MapStr{
+			"p4.user": "fred", "user.name": nil, "host.hostname": nil, "type": "p4dbeat"}},
+		{"ecs", config.SchemaECS, "p4d1", nil, common.MapStr{
+			"p4.user": nil, "user.name": "fred", "process.pid": int64(1234), "p4.pid": nil,
+			"event.duration": int64(1.5e9), "event.outcome": "failure", "event.action": "user-sync",
+			"p4.cmd": "user-sync", "p4.workspace": "fred_ws", "host.hostname": "p4d1",
+			"event.dataset": "p4d.command", "event.kind": "event", "type": nil}},
+		{"both", config.SchemaBoth, "p4d1", nil, common.MapStr{
+			"p4.user": "fred", "user.name": "fred", "host.hostname": "p4d1", "type": "p4dbeat"}},
+		{"hostname unknown", config.SchemaECS, "", nil, common.MapStr{"host.hostname": nil}},
+		{"hostname from static fields", config.SchemaECS, "p4d1", common.MapStr{"host.hostname": "p4d2"},
+			common.MapStr{"host.hostname": "p4d2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := common.MapStr{}
+			for k, v := range fields {
+				in[k] = v
+			}
+			for k, v := range tt.static {
+				in[k] = v
+			}
+			got := ecsCommand(tt.schema, tt.hostname, in)
+			for k, want := range tt.want {
+				v, ok := got[k]
+				if want == nil && ok {
+					t.Errorf("unexpected %s = %#v", k, v)
+				} else if want != nil && !reflect.DeepEqual(v, want) {
+					t.Errorf("%s = %#v, want %#v", k, v, want)
+				}
+			}
+			if len(in) != len(fields)+len(tt.static) || in["p4.user"] != "fred" {
+				t.Errorf("command fields changed: %v", in)
+			}
+		})
+	}
+}
+
+func TestECSAlert(t *testing.T) {
+	bt := newTestBeat()
+	bt.config.Schema = config.SchemaECS
+	bt.hostname = "p4d1"
+	var err error
+	bt.alerts, err = newAlertRules([]config.AlertRule{{
+		Name:        "slow",
+		Description: "Slow command",
+		When:        conditionConfig(t, map[string]interface{}{"range.p4.completed_sec.gte": 1}),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := bt.newLogInput(config.Input{Path: "log"}, "log", "log")
+	bt.publishCommand(in, p4dlog.Command{Cmd: "user-sync", User: "fred", Pid: 1, LineNo: 1,
+		StartTime: time.Unix(1536228000, 0), EndTime: time.Unix(1536228002, 0), CompletedLapse: 2}, nil)
+	events := bt.published()
+	if len(events) != 2 {
+		t.Fatalf("%d events published, want command and alert", len(events))
+	}
+	alert := events[1].Fields
+	want := common.MapStr{
+		"rule.name":         "slow",
+		"rule.description":  "Slow command",
+		"p4.alert.severity": defaultAlertSeverity,
+		"event.kind":        "alert",
+		"event.dataset":     "p4d.alert",
+		"event.action":      "user-sync",
+		"user.name":         "fred",
+		"host.hostname":     "p4d1",
+	}
+	for k, v := range want {
+		if !reflect.DeepEqual(alert[k], v) {
+			t.Errorf("%s = %#v, want %#v", k, alert[k], v)
+		}
+	}
+	for _, k := range []string{"p4.alert.rule", "p4.alert.description", "p4.user", "type"} {
+		if v, ok := alert[k]; ok {
+			t.Errorf("unexpected %s = %#v", k, v)
+		}
+	}
+}
+
+// conditionConfig returns the libbeat condition config for the dotted settings
+func conditionConfig(t *testing.T, settings map[string]interface{}) conditions.Config {
+	var c conditions.Config
+	cfg, err := common.NewConfigFrom(settings)
+	if err == nil {
+		err = cfg.Unpack(&c)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
@@ -0,0 +1,153 @@
+package beater
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	p4dlog "github.com/rcowham/go-libp4dlog"
+	"github.com/rcowham/p4dbeat/config"
+)
+
+// commandFilter is a configured filter with its patterns compiled
+type commandFilter struct {
+	cmds         []*regexp.Regexp
+	users        []*regexp.Regexp
+	apps         []*regexp.Regexp
+	ips          []*net.IPNet
+	minCompleted time.Duration
+	maxCompleted time.Duration
+}
+
+// commandFilters decides which commands are published. Commands are filtered as soon as they
+// are parsed, before any fields are built.
+type commandFilters struct {
+	include []commandFilter
+	exclude []commandFilter
+}
+
+// newCommandFilters returns nil if no filters are configured
+func newCommandFilters(cfg config.Filters) (*commandFilters, error) {
+	if len(cfg.Include) == 0 && len(cfg.Exclude) == 0 {
+		return nil, nil
+	}
+	f := &commandFilters{}
+	var err error
+	if f.include, err = compileFilters(cfg.Include); err != nil {
+		return nil, err
+	}
+	if f.exclude, err = compileFilters(cfg.Exclude); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func compileFilters(cfgs []config.CommandFilter) ([]commandFilter, error) {
+	filters := make([]commandFilter, 0, len(cfgs))
+	for _, c := range cfgs {
+		f := commandFilter{minCompleted: c.MinCompleted, maxCompleted: c.MaxCompleted}
+		var err error
+		if f.cmds, err = compilePatterns(c.Cmds); err != nil {
+			return nil, err
+		}
+		if f.users, err = compilePatterns(c.Users); err != nil {
+			return nil, err
+		}
+		if f.apps, err = compilePatterns(c.Apps); err != nil {
+			return nil, err
+		}
+		for _, ip := range c.IPs {
+			if !strings.Contains(ip, "/") {
+				if strings.Contains(ip, ":") {
+					ip += "/128"
+				} else {
+					ip += "/32"
+				}
+			}
+			_, n, err := net.ParseCIDR(ip)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid filter IP '%s': %v", ip, err)
+			}
+			f.ips = append(f.ips, n)
+		}
+		filters = append(filters, f)
+	}
+	return filters, nil
+}
+
+// compilePatterns compiles the regexes to match whole values
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	res := make([]*regexp.Regexp, 0, len(patterns))
+	for _, p := range patterns {
+		re, err := regexp.Compile("^(?:" + p + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("Invalid filter pattern '%s': %v", p, err)
+		}
+		res = append(res, re)
+	}
+	return res, nil
+}
+
+// publish returns true if the command should be published
+func (f *commandFilters) publish(command *p4dlog.Command) bool {
+	if len(f.include) > 0 && !matchAnyFilter(f.include, command) {
+		return false
+	}
+	return !matchAnyFilter(f.exclude, command)
+}
+
+func matchAnyFilter(filters []commandFilter, command *p4dlog.Command) bool {
+	for i := range filters {
+		if filters[i].match(command) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *commandFilter) match(command *p4dlog.Command) bool {
+	completed := time.Duration(float64(command.CompletedLapse) * float64(time.Second))
+	if f.minCompleted > 0 && completed < f.minCompleted {
+		return false
+	}
+	if f.maxCompleted > 0 && completed >= f.maxCompleted {
+		return false
+	}
+	if !matchAnyPattern(f.cmds, command.Cmd) || !matchAnyPattern(f.users, command.User) ||
+		!matchAnyPattern(f.apps, command.App) {
+		return false
+	}
+	if len(f.ips) > 0 {
+		ip, proxyIP := splitIP(command.IP)
+		return matchAnyNet(f.ips, ip) || matchAnyNet(f.ips, proxyIP)
+	}
+	return true
+}
+
+// matchAnyPattern returns true if there are no patterns or one matches
+func matchAnyPattern(patterns []*regexp.Regexp, value string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, re := range patterns {
+		if re.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchAnyNet(nets []*net.IPNet, ip string) bool {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+	for _, n := range nets {
+		if n.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
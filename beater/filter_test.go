@@ -0,0 +1,84 @@
+//go:build !integration
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+	"time"
+
+	p4dlog "github.com/rcowham/go-libp4dlog"
+	"github.com/rcowham/p4dbeat/config"
+)
+
+func TestCommandFilters(t *testing.T) {
+	sync := &p4dlog.Command{Cmd: "user-sync", User: "fred", App: "p4v/2020.1", IP: "10.1.2.3", CompletedLapse: 2}
+	fstat := &p4dlog.Command{Cmd: "user-fstat", User: "build", App: "jenkins", IP: "10.9.0.1/10.1.2.4", CompletedLapse: 0.01}
+	monitor := &p4dlog.Command{Cmd: "user-monitor", User: "admin", IP: "fd00::5", CompletedLapse: 0.5}
+	commands := []*p4dlog.Command{sync, fstat, monitor}
+	tests := []struct {
+		name    string
+		filters config.Filters
+		want    []bool // published, for each command
+	}{
+		{"include cmd", config.Filters{Include: []config.CommandFilter{{Cmds: []string{"user-sync"}}}},
+			[]bool{true, false, false}},
+		{"whole values only", config.Filters{Include: []config.CommandFilter{{Cmds: []string{"sync", "user-f"}}}},
+			[]bool{false, false, false}},
+		{"regex", config.Filters{Include: []config.CommandFilter{{Cmds: []string{"user-(sync|fstat)"}}}},
+			[]bool{true, true, false}},
+		{"exclude", config.Filters{Exclude: []config.CommandFilter{{Users: []string{"build|admin"}}}},
+			[]bool{true, false, false}},
+		{"exclude wins", config.Filters{
+			Include: []config.CommandFilter{{Cmds: []string{"user-.*"}}},
+			Exclude: []config.CommandFilter{{Cmds: []string{"user-monitor"}}}},
+			[]bool{true, true, false}},
+		{"all fields of a filter match", config.Filters{Exclude: []config.CommandFilter{{Cmds: []string{"user-fstat"}, Users: []string{"fred"}}}},
+			[]bool{true, true, true}},
+		{"any filter matches", config.Filters{Include: []config.CommandFilter{{Users: []string{"fred"}}, {Apps: []string{"jenkins"}}}},
+			[]bool{true, true, false}},
+		{"app", config.Filters{Exclude: []config.CommandFilter{{Apps: []string{"p4v/.*"}}}},
+			[]bool{false, true, true}},
+		{"min completed", config.Filters{Include: []config.CommandFilter{{MinCompleted: 500 * time.Millisecond}}},
+			[]bool{true, false, true}},
+		{"max completed", config.Filters{Exclude: []config.CommandFilter{{MaxCompleted: 500 * time.Millisecond}}},
+			[]bool{true, false, true}},
+		{"ip", config.Filters{Include: []config.CommandFilter{{IPs: []string{"10.1.2.3"}}}},
+			[]bool{true, false, false}},
+		{"subnet matches client behind proxy", config.Filters{Include: []config.CommandFilter{{IPs: []string{"10.1.0.0/16"}}}},
+			[]bool{true, true, false}},
+		{"proxy ip", config.Filters{Exclude: []config.CommandFilter{{IPs: []string{"10.9.0.1"}}}},
+			[]bool{true, false, true}},
+		{"ipv6", config.Filters{Include: []config.CommandFilter{{IPs: []string{"fd00::5", "192.168.0.0/16"}}}},
+			[]bool{false, false, true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := newCommandFilters(tt.filters)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i, c := range commands {
+				if got := f.publish(c); got != tt.want[i] {
+					t.Errorf("%s published %v, want %v", c.Cmd, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCommandFiltersInvalid(t *testing.T) {
+	if f, err := newCommandFilters(config.Filters{}); f != nil || err != nil {
+		t.Errorf("filters %v, %v when none configured", f, err)
+	}
+	for _, filters := range []config.Filters{
+		{Include: []config.CommandFilter{{Cmds: []string{"user-("}}}},
+		{Exclude: []config.CommandFilter{{Users: []string{"*"}}}},
+		{Include: []config.CommandFilter{{IPs: []string{"10.1.2"}}}},
+		{Exclude: []config.CommandFilter{{IPs: []string{"10.0.0.0/33"}}}},
+	} {
+		if _, err := newCommandFilters(filters); err == nil {
+			t.Errorf("no error for %+v", filters)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package beater
+
+import (
+	"bufio"
+	"compress/bzip2"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common/acker"
+	p4dlog "github.com/rcowham/go-libp4dlog"
+	"github.com/rcowham/p4dbeat/config"
+)
+
+// Contention is analysed every this many commands during an import, so lock holders are
+// dropped after the retention period rather than kept until the end
+const importAnalyseCommands = 1000
+
+// The parser only outputs completed commands once its clock has moved on, so during an import
+// the clock is moved on every this many lines rather than in real time. Commands are then output
+// as the import goes, in log order, instead of all at once at the end.
+const importTickLines = 1000
+
+// importStats summarises a historical import
+type importStats struct {
+	files    int
+	lines    int64
+	commands int64
+	filtered int64 // commands not published because of filters
+}
+
+// openLog opens a log file for reading, decompressing gzip/bzip2 files
+// (detected by their magic bytes rather than relying on the file extension)
+func openLog(path string) (io.ReadCloser, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	br := bufio.NewReader(f)
+	magic, _ := br.Peek(3)
+	switch {
+	case len(magic) >= 2 && magic[0] == 0x1f && magic[1] == 0x8b:
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("Failed to read gzip file '%s': %v", path, err)
+		}
+		return readCloser{gz, f}, nil
+	case len(magic) == 3 && string(magic) == "BZh":
+		return readCloser{bzip2.NewReader(br), f}, nil
+	}
+	return readCloser{br, f}, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// runImport reads each of the configured files from start to end, publishes all the
+// commands found, waits for the outputs to acknowledge them, then returns so the beat exits.
+// Alert notifications are sent at the end rather than waiting for their windows.
+func (bt *P4dbeat) runImport(b *beat.Beat) error {
+	var pending sync.WaitGroup
+	var err error
+	if bt.config.Notifier.Enabled {
+		// Only for unsent notifications - imports don't store offsets
+		store, err := bt.registry.Get("p4dbeat")
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+		stopNotifier := bt.startNotifier(store)
+		defer func() {
+			stopNotifier()
+			bt.notifier.flush(bt.done)
+		}()
+	}
+	bt.client, err = b.Publisher.ConnectWith(beat.ClientConfig{
+		PublishMode: beat.GuaranteedSend,
+		ACKHandler: acker.Counting(func(n int) {
+			pending.Add(-n)
+		}),
+	})
+	if err != nil {
+		return err
+	}
+	// Every event published (commands, alerts, ...) is pending until acknowledged
+	bt.onPublish = func() { pending.Add(1) }
+
+	if bt.contention != nil {
+		bt.contention.importing = true
+	}
+	start := time.Now()
+	stats := importStats{}
+	for _, path := range bt.config.Import.Files {
+		in := bt.newLogInput(config.Input{Path: path, Fields: bt.config.Import.Fields,
+			Timezone: bt.config.Import.Timezone}, path, "")
+		in.hostname = "" // imported logs may be from any server
+		if err := bt.importFile(in, &stats); err != nil {
+			bt.log.Errorf("Failed to import '%s': %v", path, err)
+			continue
+		}
+		stats.files++
+		select {
+		case <-bt.done:
+			return nil
+		default:
+		}
+	}
+
+	if bt.rollup != nil {
+		bt.publishRollups(bt.rollup.take(time.Time{}))
+	}
+	if bt.latency != nil {
+		bt.publishPercentiles(bt.latency.take(time.Time{}))
+	}
+	if bt.contention != nil {
+		bt.publishContention(bt.contention.analyse(true))
+	}
+
+	bt.log.Infof("Waiting for events for %d commands to be acknowledged", stats.commands)
+	acked := make(chan struct{})
+	go func() {
+		pending.Wait()
+		close(acked)
+	}()
+	select {
+	case <-acked:
+	case <-bt.done:
+		return nil
+	}
+
+	filtered := ""
+	if stats.filtered > 0 {
+		filtered = fmt.Sprintf(" (%d filtered out)", stats.filtered)
+	}
+	summary := fmt.Sprintf("Imported %d files, %d lines, %d commands%s in %v",
+		stats.files, stats.lines, stats.commands, filtered, time.Since(start).Round(time.Second))
+	bt.log.Info(summary)
+	fmt.Println(summary)
+	return nil
+}
+
+// importFile parses a single log to EOF, flushing all pending commands from the parser at the end
+func (bt *P4dbeat) importFile(in *logInput, stats *importStats) error {
+	r, err := openLog(in.path)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	bt.log.Infof("Importing '%s'", in.path)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fp := p4dlog.NewP4dFileParser(bt.log)
+	ticks := make(chan time.Time, 1)
+	commands := fp.LogParser(ctx, in.lines, ticks)
+
+	// Lines are read on a separate goroutine as the parser output has to be drained at the same time.
+	// Closing the lines channel at EOF makes the parser output all remaining commands.
+	readErr := make(chan error, 1)
+	go func() {
+		defer close(ticks)
+		defer close(in.lines)
+		clock := time.Now()
+		br := bufio.NewReader(r)
+		for {
+			line, err := br.ReadString('\n')
+			if len(line) > 0 {
+				stats.lines++
+				if stats.lines%importTickLines == 0 {
+					clock = clock.Add(time.Second)
+					select {
+					case ticks <- clock:
+					default: // parser hasn't taken the last one yet
+					}
+				}
+				select {
+				case in.lines <- strings.TrimRight(line, "\n"):
+				case <-bt.done:
+					readErr <- nil
+					return
+				}
+			}
+			if err != nil {
+				if err == io.EOF {
+					err = nil
+				}
+				readErr <- err
+				return
+			}
+		}
+	}()
+
+	for command := range commands {
+		if bt.filters != nil && !bt.filters.publish(&command) {
+			stats.filtered++
+			continue
+		}
+		bt.publishCommand(in, command, nil)
+		stats.commands++
+		if bt.contention != nil && stats.commands%importAnalyseCommands == 0 {
+			bt.publishContention(bt.contention.analyse(false))
+		}
+	}
+	return <-readErr
+}
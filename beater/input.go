@@ -0,0 +1,119 @@
+package beater
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/rcowham/p4dbeat/config"
+)
+
+// logInput is a single log file being tailed, with its own parser and offset state
+type logInput struct {
+	path           string
+	rotatedPattern string
+	stateKey       string
+	parser         string
+	fields         common.MapStr // flattened static fields stamped on every event
+	fieldsKey      string        // identifies the static fields, for aggregates (see staticFieldsKey)
+	hostname       string        // host of the p4d server for ECS host.hostname, if known
+	clock          *logClock     // converts log times from the timezone of the server
+	audit          *auditRollup  // for p4audit inputs with rollups enabled
+	lines          chan string
+}
+
+// newLogInput creates an input for the specified file using the input config
+func (bt *P4dbeat) newLogInput(ic config.Input, path, stateKey string) *logInput {
+	parser := ic.Parser
+	if parser == "" {
+		parser = config.ParserP4dLog
+	}
+	timezone := ic.Timezone
+	if timezone == "" {
+		timezone = bt.config.Timezone
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil { // already validated
+		loc = time.UTC
+	}
+	fields := ic.Fields.Flatten()
+	return &logInput{
+		path:           path,
+		rotatedPattern: ic.RotatedPattern,
+		stateKey:       stateKey,
+		parser:         parser,
+		fields:         fields,
+		fieldsKey:      staticFieldsKey(fields),
+		hostname:       bt.hostname, // logs being tailed are local
+		clock:          newLogClock(loc),
+		audit:          newAuditRollup(ic.AuditRollup),
+		lines:          make(chan string, 100),
+	}
+}
+
+// expandInputs returns the inputs to tail. If no inputs are configured, the top
+// level path is used (with the original state key for backwards compatibility).
+// Globs are expanded so each matching file is tailed separately with its own state.
+// Globs matching no files are only logged on the first scan.
+func (bt *P4dbeat) expandInputs(firstScan bool) ([]*logInput, error) {
+	if len(bt.config.Inputs) == 0 {
+		ic := config.Input{Path: bt.config.Path, RotatedPattern: bt.config.RotatedPattern}
+		return []*logInput{bt.newLogInput(ic, ic.Path, offsetKeyName)}, nil
+	}
+	inputs := make([]*logInput, 0)
+	for _, ic := range bt.config.Inputs {
+		stateKey := ic.StateKey
+		if stateKey == "" {
+			stateKey = ic.Path
+		}
+		if !hasGlob(ic.Path) {
+			inputs = append(inputs, bt.newLogInput(ic, ic.Path, stateKey))
+			continue
+		}
+		matches, err := filepath.Glob(ic.Path)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid input path '%s': %v", ic.Path, err)
+		}
+		if len(matches) == 0 && firstScan {
+			bt.log.Warnf("No files found matching input path '%s'", ic.Path)
+		}
+		for _, m := range matches {
+			// Rotated logs are found via their own pattern
+			mc := ic
+			mc.RotatedPattern = ""
+			inputs = append(inputs, bt.newLogInput(mc, m, fmt.Sprintf("%s:%s", stateKey, m)))
+		}
+	}
+	return inputs, nil
+}
+
+// serverID returns the p4.serverid static field for the input, if set
+func (in *logInput) serverID() string {
+	if v, ok := in.fields["p4.serverid"]; ok {
+		return fmt.Sprint(v)
+	}
+	return ""
+}
+
+// staticFieldsKey returns a key which is the same for inputs with the same static fields, so
+// that rollups etc. are kept separately for each server (or other static fields) and combined
+// across the inputs for it
+func staticFieldsKey(fields common.MapStr) string {
+	if len(fields) == 0 {
+		return ""
+	}
+	b, _ := json.Marshal(fields) // sorts the keys
+	return string(b)
+}
+
+func hasGlob(path string) bool {
+	for _, c := range path {
+		switch c {
+		case '*', '?', '[':
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,208 @@
+package beater
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+// The JSON input accepts command-like JSON records POSTed over HTTP, either on a TCP address
+// or a Unix socket, and feeds them to publishEvent. The body is a single JSON object or an
+// array of them, e.g.
+//
+//	{"cmd": "user-submit", "user": "fred", "workspace": "fred_ws", "startTime": "2020/05/11 16:12:47"}
+//
+// Records are published as they are: filters, sampling, alerts and deterministic IDs only
+// apply to commands read from logs.
+
+// startJSONInput starts the configured listeners, returning a function to stop them. Requests
+// being handled are given stopTimeout to finish, so that records are either queued or rejected.
+func (bt *P4dbeat) startJSONInput() (func(), error) {
+	cfg := bt.config.JSONInput
+	listeners := make([]net.Listener, 0)
+	if cfg.Host != "" {
+		l, err := net.Listen("tcp", cfg.Host)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to listen on '%s': %v", cfg.Host, err)
+		}
+		listeners = append(listeners, l)
+	}
+	if cfg.Socket != "" {
+		os.Remove(cfg.Socket) // left behind if we weren't shut down cleanly
+		l, err := net.Listen("unix", cfg.Socket)
+		if err != nil {
+			for _, l := range listeners {
+				l.Close()
+			}
+			return nil, fmt.Errorf("Failed to listen on '%s': %v", cfg.Socket, err)
+		}
+		listeners = append(listeners, l)
+	}
+	servers := make([]*http.Server, 0, len(listeners))
+	for _, l := range listeners {
+		srv := &http.Server{Handler: http.HandlerFunc(bt.handleJSON)}
+		servers = append(servers, srv)
+		bt.log.Infof("JSON input listening on %s", l.Addr())
+		go func(l net.Listener) {
+			if err := srv.Serve(l); err != nil && err != http.ErrServerClosed {
+				bt.log.Errorf("JSON input on %s stopped: %v", l.Addr(), err)
+			}
+		}(l)
+	}
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), stopTimeout)
+		defer cancel()
+		for _, srv := range servers {
+			if err := srv.Shutdown(ctx); err != nil {
+				srv.Close()
+			}
+		}
+	}, nil
+}
+
+// handleJSON validates the records in the request and queues them to be published.
+// Nothing is queued unless all the records are valid.
+func (bt *P4dbeat) handleJSON(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		jsonResponse(w, http.StatusMethodNotAllowed, fmt.Errorf("only POST is supported"))
+		return
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, bt.config.JSONInput.MaxBodySize))
+	if err != nil {
+		// MaxBytesReader has no error type to check for
+		status := http.StatusBadRequest
+		if err.Error() == "http: request body too large" {
+			status = http.StatusRequestEntityTooLarge
+		}
+		jsonResponse(w, status, err)
+		return
+	}
+	records, err := parseJSONRecords(body, bt.loc)
+	if err != nil {
+		jsonResponse(w, http.StatusBadRequest, err)
+		return
+	}
+	for _, rec := range records {
+		select {
+		case bt.events <- string(rec):
+		case <-bt.done:
+			jsonResponse(w, http.StatusServiceUnavailable, fmt.Errorf("shutting down"))
+			return
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	fmt.Fprintf(w, "{\"accepted\":%d}\n", len(records))
+}
+
+func jsonResponse(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
+
+// parseJSONRecords splits the body into the records to publish, checking each is a valid record
+func parseJSONRecords(body []byte, loc *time.Location) ([]json.RawMessage, error) {
+	body = bytes.TrimSpace(body)
+	if len(body) == 0 {
+		return nil, fmt.Errorf("empty request")
+	}
+	records := []json.RawMessage{body}
+	if body[0] == '[' {
+		if err := json.Unmarshal(body, &records); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %v", err)
+		}
+	}
+	for i, rec := range records {
+		if _, err := decodeJSONRecord(rec, loc); err != nil {
+			return nil, fmt.Errorf("record %d: %v", i+1, err)
+		}
+	}
+	return records, nil
+}
+
+// jsonRecord is a decoded JSON input record. Lapses are nil if not set.
+type jsonRecord struct {
+	cmd, user, workspace, ip, args string
+	startTime, endTime             time.Time
+	computeLapse, completedLapse   *float64
+}
+
+// decodeJSONRecord decodes a record, which must be a JSON object with fields of the right types,
+// so they aren't rejected by the index mapping after the request has been accepted
+func decodeJSONRecord(data []byte, loc *time.Location) (*jsonRecord, error) {
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("invalid JSON object: %v", err)
+	}
+	if m == nil {
+		return nil, fmt.Errorf("not a JSON object")
+	}
+	rec := &jsonRecord{}
+	var startTime, endTime string
+	strs := []struct {
+		key  string
+		dest *string
+	}{
+		{"cmd", &rec.cmd},
+		{"user", &rec.user},
+		{"workspace", &rec.workspace},
+		{"ip", &rec.ip},
+		{"args", &rec.args},
+		{"startTime", &startTime},
+		{"endTime", &endTime},
+	}
+	for _, f := range strs {
+		if v, ok := m[f.key]; ok && v != nil {
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s must be a string", f.key)
+			}
+			*f.dest = s
+		}
+	}
+	if rec.ip != "" && net.ParseIP(rec.ip) == nil {
+		return nil, fmt.Errorf("ip '%s' is not an IP address", rec.ip)
+	}
+	var err error
+	if startTime != "" {
+		if rec.startTime, err = parseJSONTime(startTime, loc); err != nil {
+			return nil, fmt.Errorf("startTime: %v", err)
+		}
+	}
+	if endTime != "" {
+		if rec.endTime, err = parseJSONTime(endTime, loc); err != nil {
+			return nil, fmt.Errorf("endTime: %v", err)
+		}
+	}
+	if rec.computeLapse, err = jsonLapse(m, "computeLapse"); err != nil {
+		return nil, err
+	}
+	if rec.completedLapse, err = jsonLapse(m, "completedLapse"); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// jsonLapse returns the lapse in seconds, which may be a number or a numeric string
+func jsonLapse(m map[string]interface{}, key string) (*float64, error) {
+	switch v := m[key].(type) {
+	case nil:
+		return nil, nil
+	case float64:
+		return &v, nil
+	case string:
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return &f, nil
+		}
+	}
+	return nil, fmt.Errorf("%s must be a number of seconds", key)
+}
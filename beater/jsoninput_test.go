@@ -0,0 +1,131 @@
+//go:build !integration
+// +build !integration
+
+package beater
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDecodeJSONRecord(t *testing.T) {
+	lapse := func(f float64) *float64 { return &f }
+	tests := []struct {
+		name    string
+		data    string
+		want    *jsonRecord
+		wantErr string
+	}{
+		{"all fields", `{"cmd": "user-submit", "user": "fred", "workspace": "fred_ws", "ip": "10.0.0.1",
+			"args": "-c 123", "startTime": "2020/05/11 16:12:47", "endTime": "2020-05-11T16:12:50Z",
+			"computeLapse": 0.5, "completedLapse": "3"}`,
+			&jsonRecord{cmd: "user-submit", user: "fred", workspace: "fred_ws", ip: "10.0.0.1", args: "-c 123",
+				startTime: time.Date(2020, 5, 11, 16, 12, 47, 0, time.UTC), endTime: time.Date(2020, 5, 11, 16, 12, 50, 0, time.UTC),
+				computeLapse: lapse(0.5), completedLapse: lapse(3)}, ""},
+		{"empty object", `{}`, &jsonRecord{}, ""},
+		{"null fields", `{"cmd": null, "completedLapse": null}`, &jsonRecord{}, ""},
+		{"unknown fields", `{"cmd": "user-info", "other": [1]}`, &jsonRecord{cmd: "user-info"}, ""},
+		{"invalid JSON", `{"cmd": `, nil, "invalid JSON object"},
+		{"array", `["user-info"]`, nil, "invalid JSON object"},
+		{"null", `null`, nil, "not a JSON object"},
+		{"number for string", `{"user": 1}`, nil, "user must be a string"},
+		{"object for string", `{"cmd": {"name": "user-info"}}`, nil, "cmd must be a string"},
+		{"invalid ip", `{"ip": "fred"}`, nil, "ip 'fred' is not an IP address"},
+		{"invalid startTime", `{"startTime": "11/05/2020"}`, nil, "startTime: invalid time"},
+		{"number for endTime", `{"endTime": 1589213567}`, nil, "endTime must be a string"},
+		{"invalid lapse", `{"computeLapse": "1s"}`, nil, "computeLapse must be a number of seconds"},
+		{"bool lapse", `{"completedLapse": true}`, nil, "completedLapse must be a number of seconds"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeJSONRecord([]byte(tt.data), time.UTC)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.cmd != tt.want.cmd || got.user != tt.want.user || got.workspace != tt.want.workspace ||
+				got.ip != tt.want.ip || got.args != tt.want.args ||
+				!got.startTime.Equal(tt.want.startTime) || !got.endTime.Equal(tt.want.endTime) {
+				t.Errorf("decoded %+v, want %+v", got, tt.want)
+			}
+			for _, l := range []struct {
+				name      string
+				got, want *float64
+			}{{"computeLapse", got.computeLapse, tt.want.computeLapse}, {"completedLapse", got.completedLapse, tt.want.completedLapse}} {
+				if (l.got == nil) != (l.want == nil) || l.got != nil && *l.got != *l.want {
+					t.Errorf("%s %v, want %v", l.name, l.got, l.want)
+				}
+			}
+		})
+	}
+}
+
+// errReader fails part way through a request body, as when the client disconnects
+type errReader struct{ data string }
+
+func (r *errReader) Read(p []byte) (int, error) {
+	if r.data == "" {
+		return 0, errors.New("unexpected EOF")
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func TestHandleJSON(t *testing.T) {
+	tests := []struct {
+		name       string
+		request    func() *http.Request
+		wantStatus int
+		wantQueued int
+	}{
+		{"record", func() *http.Request {
+			return httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"cmd": "user-submit"}`))
+		}, http.StatusAccepted, 1},
+		{"records", func() *http.Request {
+			return httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`[{"cmd": "user-submit"}, {"cmd": "user-info"}]`))
+		}, http.StatusAccepted, 2},
+		{"invalid record", func() *http.Request {
+			return httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`[{"cmd": "user-submit"}, {"cmd": 1}]`))
+		}, http.StatusBadRequest, 0},
+		{"GET", func() *http.Request {
+			return httptest.NewRequest(http.MethodGet, "/", nil)
+		}, http.StatusMethodNotAllowed, 0},
+		{"too large", func() *http.Request {
+			return httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"args": "`+strings.Repeat("x", 100)+`"}`))
+		}, http.StatusRequestEntityTooLarge, 0},
+		{"read error", func() *http.Request {
+			return httptest.NewRequest(http.MethodPost, "/", &errReader{`{"cmd": "user-submit"`})
+		}, http.StatusBadRequest, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bt := newTestBeat()
+			bt.config.JSONInput.MaxBodySize = 100
+			bt.events = make(chan string, 10)
+			bt.done = make(chan struct{})
+			w := httptest.NewRecorder()
+			bt.handleJSON(w, tt.request())
+			if w.Code != tt.wantStatus {
+				t.Errorf("status %d, want %d: %s", w.Code, tt.wantStatus, w.Body)
+			}
+			if len(bt.events) != tt.wantQueued {
+				t.Errorf("%d records queued, want %d", len(bt.events), tt.wantQueued)
+			}
+			// Queued records are published by processEvents, e.g. when stopping
+			bt.processEvents()
+			if n := len(bt.published()); n != tt.wantQueued {
+				t.Errorf("%d records published, want %d", n, tt.wantQueued)
+			}
+		})
+	}
+}
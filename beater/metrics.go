@@ -0,0 +1,159 @@
+package beater
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	p4dlog "github.com/rcowham/go-libp4dlog"
+	"github.com/rcowham/p4dbeat/config"
+)
+
+// Label value used once a label has reached its maximum number of values
+const otherLabelValue = "other"
+
+// Defaults if not configured
+var (
+	defaultMetricsLabels  = []string{"cmd"}
+	defaultMetricsBuckets = []float64{0.01, 0.1, 1, 10, 60, 300, 1800}
+)
+
+// commandMetrics are Prometheus metrics from the parsed commands, similar to those from p4prometheus.
+// Command metrics are labelled by serverid plus the configured labels (cmd, user, app), and each
+// label is limited to a number of distinct values to keep cardinality under control.
+type commandMetrics struct {
+	labels         []string
+	maxLabelValues int
+	mu             sync.Mutex
+	seen           map[string]map[string]bool // label values seen, by label
+
+	registry    *prometheus.Registry
+	commands    *prometheus.CounterVec
+	errors      *prometheus.CounterVec
+	lapse       *prometheus.CounterVec
+	cpuUser     *prometheus.CounterVec
+	cpuSystem   *prometheus.CounterVec
+	rpcBytesIn  *prometheus.CounterVec
+	rpcBytesOut *prometheus.CounterVec
+	duration    *prometheus.HistogramVec
+	lockWait    *prometheus.CounterVec
+	lockHeld    *prometheus.CounterVec
+}
+
+func newCommandMetrics(cfg config.Metrics) *commandMetrics {
+	m := &commandMetrics{
+		labels:         cfg.Labels,
+		maxLabelValues: cfg.MaxLabelValues,
+		seen:           make(map[string]map[string]bool),
+		registry:       prometheus.NewRegistry(),
+	}
+	if len(m.labels) == 0 {
+		m.labels = defaultMetricsLabels
+	}
+	buckets := cfg.Buckets
+	if len(buckets) == 0 {
+		buckets = defaultMetricsBuckets
+	}
+	labels := append([]string{"serverid"}, m.labels...)
+	counter := func(name, help string, labels []string) *prometheus.CounterVec {
+		c := prometheus.NewCounterVec(prometheus.CounterOpts{Name: name, Help: help}, labels)
+		m.registry.MustRegister(c)
+		return c
+	}
+	m.commands = counter("p4_cmd_counter", "Count of commands", labels)
+	m.errors = counter("p4_cmd_error_counter", "Count of commands with errors", labels)
+	m.lapse = counter("p4_cmd_cumulative_seconds", "Cumulative completed time of commands in seconds", labels)
+	m.cpuUser = counter("p4_cmd_cpu_user_cumulative_seconds", "Cumulative user CPU of commands in seconds", labels)
+	m.cpuSystem = counter("p4_cmd_cpu_system_cumulative_seconds", "Cumulative system CPU of commands in seconds", labels)
+	m.rpcBytesIn = counter("p4_cmd_rpc_in_bytes", "Cumulative size of RPC messages received by commands in bytes", labels)
+	m.rpcBytesOut = counter("p4_cmd_rpc_out_bytes", "Cumulative size of RPC messages sent by commands in bytes", labels)
+	m.duration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "p4_cmd_duration_seconds",
+		Help:    "Completed time of commands in seconds",
+		Buckets: buckets,
+	}, labels)
+	m.registry.MustRegister(m.duration)
+	tableLabels := []string{"serverid", "table", "lock"}
+	m.lockWait = counter("p4_total_table_lock_wait_seconds", "Cumulative time waiting for table locks in seconds", tableLabels)
+	m.lockHeld = counter("p4_total_table_lock_held_seconds", "Cumulative time table locks were held in seconds", tableLabels)
+	return m
+}
+
+// labelValue returns the value for the label, or "other" if the label already has the maximum number of values
+func (m *commandMetrics) labelValue(label, value string) string {
+	if m.maxLabelValues <= 0 {
+		return value
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	seen, ok := m.seen[label]
+	if !ok {
+		seen = make(map[string]bool)
+		m.seen[label] = seen
+	}
+	if seen[value] {
+		return value
+	}
+	if len(seen) >= m.maxLabelValues {
+		return otherLabelValue
+	}
+	seen[value] = true
+	return value
+}
+
+// observe updates the metrics for the command
+func (m *commandMetrics) observe(serverID string, command *p4dlog.Command) {
+	values := []string{serverID}
+	for _, l := range m.labels {
+		var v string
+		switch l {
+		case "cmd":
+			v = command.Cmd
+		case "user":
+			v = command.User
+		case "app":
+			v = command.App
+		}
+		values = append(values, m.labelValue(l, v))
+	}
+	const mb = 1024 * 1024
+	m.commands.WithLabelValues(values...).Inc()
+	if command.CmdError {
+		m.errors.WithLabelValues(values...).Inc()
+	}
+	m.lapse.WithLabelValues(values...).Add(float64(command.CompletedLapse))
+	m.cpuUser.WithLabelValues(values...).Add(float64(command.UCpu) / 1000.0)
+	m.cpuSystem.WithLabelValues(values...).Add(float64(command.SCpu) / 1000.0)
+	m.rpcBytesIn.WithLabelValues(values...).Add(float64(command.RPCSizeIn * mb))
+	m.rpcBytesOut.WithLabelValues(values...).Add(float64(command.RPCSizeOut * mb))
+	m.duration.WithLabelValues(values...).Observe(float64(command.CompletedLapse))
+	for _, t := range command.Tables {
+		table := m.labelValue("table", strings.ToLower(t.TableName))
+		m.lockWait.WithLabelValues(serverID, table, "read").Add(float64(t.TotalReadWait) / 1000.0)
+		m.lockWait.WithLabelValues(serverID, table, "write").Add(float64(t.TotalWriteWait) / 1000.0)
+		m.lockHeld.WithLabelValues(serverID, table, "read").Add(float64(t.TotalReadHeld) / 1000.0)
+		m.lockHeld.WithLabelValues(serverID, table, "write").Add(float64(t.TotalWriteHeld) / 1000.0)
+	}
+}
+
+// startMetrics serves /metrics on the configured address, returning a function to stop it
+func (bt *P4dbeat) startMetrics() (func(), error) {
+	l, err := net.Listen("tcp", bt.config.Metrics.Host)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to listen on '%s': %v", bt.config.Metrics.Host, err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(bt.metrics.registry, promhttp.HandlerOpts{}))
+	srv := &http.Server{Handler: mux}
+	bt.log.Infof("Serving metrics on http://%s/metrics", l.Addr())
+	go func() {
+		if err := srv.Serve(l); err != nil && err != http.ErrServerClosed {
+			bt.log.Errorf("Metrics server stopped: %v", err)
+		}
+	}()
+	return func() { srv.Close() }, nil
+}
@@ -0,0 +1,314 @@
+package beater
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/statestore"
+	"github.com/rcowham/p4dbeat/config"
+	"github.com/sirupsen/logrus"
+)
+
+const notifierKeyName = "notifier"
+
+// Number of alerts described in each notification
+const maxNotificationExamples = 5
+
+// Number of alert IDs kept to deduplicate alerts, e.g. for commands re-read after a restart
+const maxNotifiedAlerts = 10000
+
+// alertInfo is what the notifier needs from an alert event
+type alertInfo struct {
+	id          string
+	rule        string
+	severity    string
+	description string
+	serverID    string
+	time        time.Time
+	summary     string
+}
+
+// notification is the alerts for a rule within a window. Notifications are kept in the
+// state store until they have been sent, so they survive restarts.
+type notification struct {
+	Rule        string   `struct:"rule"`
+	Severity    string   `struct:"severity"`
+	Description string   `struct:"description"`
+	ServerID    string   `struct:"serverid"`
+	Count       int      `struct:"count"`
+	First       int64    `struct:"first"` // unix time of first and last alerts
+	Last        int64    `struct:"last"`
+	Examples    []string `struct:"examples"`
+	Due         int64    `struct:"due"` // unix time the window ends and it can be sent
+	Attempts    int      `struct:"attempts"`
+	NextAttempt int64    `struct:"next_attempt"`
+}
+
+// notifiedAlert records the ID of an alert which has been added to a notification
+type notifiedAlert struct {
+	ID   string `struct:"id"`
+	Time int64  `struct:"time"` // unix time it was added
+}
+
+type notifierState struct {
+	Pending  []notification  `struct:"pending"`
+	Notified []notifiedAlert `struct:"notified"` // oldest first
+}
+
+// notifier POSTs alerts to a webhook. Alerts for the same rule (and server) are grouped into
+// one notification per window, which also limits the rate of notifications. Failed requests
+// are retried with exponential backoff. Alerts with IDs (see deterministic_id) are only
+// notified once within the dedup TTL, however many times the command is read.
+type notifier struct {
+	cfg    config.Notifier
+	store  *statestore.Store
+	client *http.Client
+	alerts chan alertInfo
+	state  notifierState
+	log    *logrus.Logger
+}
+
+func newNotifier(cfg config.Notifier, store *statestore.Store, log *logrus.Logger) *notifier {
+	n := &notifier{
+		cfg:    cfg,
+		store:  store,
+		client: &http.Client{Timeout: cfg.Timeout},
+		alerts: make(chan alertInfo, 100),
+		log:    log,
+	}
+	if ok, err := store.Has(notifierKeyName); err == nil && ok {
+		if err := store.Get(notifierKeyName, &n.state); err != nil {
+			log.Warnf("Failed to load unsent notifications: %v", err)
+		}
+	}
+	if len(n.state.Pending) > 0 {
+		log.Infof("%d unsent notifications loaded", len(n.state.Pending))
+	}
+	return n
+}
+
+// notify queues the alert for the notifier - it is dropped if the notifier is falling behind
+func (n *notifier) notify(a alertInfo) {
+	select {
+	case n.alerts <- a:
+	default:
+		n.log.Warnf("Notifier queue full, dropping alert for rule '%s'", a.rule)
+	}
+}
+
+// run adds alerts to notifications and sends them when due, until done is closed
+func (n *notifier) run(done <-chan struct{}) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			// keep the alerts queued to send after restart
+			for len(n.alerts) > 0 {
+				n.add(<-n.alerts, time.Now())
+			}
+			n.save()
+			return
+		case a := <-n.alerts:
+			n.add(a, time.Now())
+			n.save()
+		case now := <-ticker.C:
+			if n.sendDue(now, done) {
+				n.save()
+			}
+		}
+	}
+}
+
+// add adds the alert to the open notification for its rule, or starts a new one
+func (n *notifier) add(a alertInfo, now time.Time) {
+	if n.notified(a.id, now) {
+		return
+	}
+	var nt *notification
+	for i := range n.state.Pending {
+		p := &n.state.Pending[i]
+		if p.Rule == a.rule && p.ServerID == a.serverID && p.Due > now.Unix() {
+			nt = p
+			break
+		}
+	}
+	if nt == nil {
+		if len(n.state.Pending) >= n.cfg.MaxPending && n.cfg.MaxPending > 0 {
+			n.log.Warnf("Too many unsent notifications, dropping the oldest for rule '%s'", n.state.Pending[0].Rule)
+			n.state.Pending = n.state.Pending[1:]
+		}
+		n.state.Pending = append(n.state.Pending, notification{
+			Rule:        a.rule,
+			Severity:    a.severity,
+			Description: a.description,
+			ServerID:    a.serverID,
+			First:       a.time.Unix(),
+			Due:         now.Add(n.cfg.Window).Unix(),
+		})
+		nt = &n.state.Pending[len(n.state.Pending)-1]
+	}
+	nt.Count++
+	if a.time.Unix() < nt.First {
+		nt.First = a.time.Unix()
+	}
+	if a.time.Unix() > nt.Last {
+		nt.Last = a.time.Unix()
+	}
+	if len(nt.Examples) < maxNotificationExamples {
+		nt.Examples = append(nt.Examples, a.summary)
+	}
+}
+
+// notified returns whether the alert has already been notified within the dedup TTL, and
+// records it if not. IDs older than the TTL are forgotten.
+func (n *notifier) notified(id string, now time.Time) bool {
+	expired := now.Add(-n.cfg.DedupTTL).Unix()
+	for len(n.state.Notified) > 0 && (n.state.Notified[0].Time <= expired || len(n.state.Notified) >= maxNotifiedAlerts) {
+		n.state.Notified = n.state.Notified[1:]
+	}
+	if id == "" {
+		return false
+	}
+	for _, na := range n.state.Notified {
+		if na.ID == id {
+			return true
+		}
+	}
+	n.state.Notified = append(n.state.Notified, notifiedAlert{ID: id, Time: now.Unix()})
+	return false
+}
+
+// flush sends all the notifications now, without waiting for their windows to end, e.g. at the
+// end of an import. Queued alerts are added first. Notifications which fail are kept to retry.
+func (n *notifier) flush(done <-chan struct{}) {
+	for len(n.alerts) > 0 {
+		n.add(<-n.alerts, time.Now())
+	}
+	for i := range n.state.Pending {
+		n.state.Pending[i].Due = 0
+		n.state.Pending[i].NextAttempt = 0
+	}
+	n.sendDue(time.Now(), done)
+	n.save()
+}
+
+// sendDue sends the notifications which are due, returning true if any were sent or retried
+func (n *notifier) sendDue(now time.Time, done <-chan struct{}) bool {
+	changed := false
+	pending := n.state.Pending[:0]
+	for _, nt := range n.state.Pending {
+		select {
+		case <-done: // keep the rest to send after restart
+			pending = append(pending, nt)
+			continue
+		default:
+		}
+		if nt.Due > now.Unix() || nt.NextAttempt > now.Unix() {
+			pending = append(pending, nt)
+			continue
+		}
+		changed = true
+		if err := n.send(&nt); err != nil {
+			nt.Attempts++
+			backoff := n.cfg.Backoff << uint(nt.Attempts-1)
+			if backoff > n.cfg.MaxBackoff || backoff <= 0 {
+				backoff = n.cfg.MaxBackoff
+			}
+			nt.NextAttempt = now.Add(backoff).Unix()
+			n.log.Warnf("Failed to send notification for rule '%s' (attempt %d), retrying in %v: %v",
+				nt.Rule, nt.Attempts, backoff, err)
+			pending = append(pending, nt)
+			continue
+		}
+		n.log.Infof("Sent notification for rule '%s' (%d alerts)", nt.Rule, nt.Count)
+	}
+	n.state.Pending = pending
+	return changed
+}
+
+func (n *notifier) save() {
+	if err := n.store.Set(notifierKeyName, n.state); err != nil {
+		n.log.Errorf("Failed to store unsent notifications: %v", err)
+	}
+}
+
+func (n *notifier) send(nt *notification) error {
+	body, err := notificationPayload(n.cfg.Format, nt)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, n.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range n.cfg.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s", resp.Status)
+	}
+	return nil
+}
+
+// notificationText is the message for chat formats, e.g.
+//
+//	[critical] slow_sync: 3 alerts from 10:00:01 to 10:00:45 UTC
+func notificationText(nt *notification) string {
+	var b strings.Builder
+	server := ""
+	if nt.ServerID != "" {
+		server = fmt.Sprintf(" on %s", nt.ServerID)
+	}
+	first, last := time.Unix(nt.First, 0).UTC(), time.Unix(nt.Last, 0).UTC()
+	fmt.Fprintf(&b, "[%s] %s%s: %d alerts from %s to %s", nt.Severity, nt.Rule, server, nt.Count,
+		first.Format(time.RFC3339), last.Format(time.RFC3339))
+	if nt.Description != "" {
+		fmt.Fprintf(&b, "\n%s", nt.Description)
+	}
+	for _, e := range nt.Examples {
+		fmt.Fprintf(&b, "\n- %s", e)
+	}
+	if nt.Count > len(nt.Examples) {
+		fmt.Fprintf(&b, "\n- ... and %d more", nt.Count-len(nt.Examples))
+	}
+	return b.String()
+}
+
+func notificationPayload(format string, nt *notification) ([]byte, error) {
+	switch format {
+	case config.NotifierSlack:
+		return json.Marshal(map[string]interface{}{"text": notificationText(nt)})
+	case config.NotifierTeams:
+		return json.Marshal(map[string]interface{}{
+			"@type":    "MessageCard",
+			"@context": "http://schema.org/extensions",
+			"summary":  fmt.Sprintf("p4dbeat alert %s", nt.Rule),
+			"title":    fmt.Sprintf("p4dbeat alert: %s (%s)", nt.Rule, nt.Severity),
+			"text":     strings.Replace(notificationText(nt), "\n", "\n\n", -1),
+		})
+	}
+	return json.Marshal(map[string]interface{}{
+		"rule":        nt.Rule,
+		"severity":    nt.Severity,
+		"description": nt.Description,
+		"serverid":    nt.ServerID,
+		"count":       nt.Count,
+		"first_time":  time.Unix(nt.First, 0).UTC().Format(time.RFC3339),
+		"last_time":   time.Unix(nt.Last, 0).UTC().Format(time.RFC3339),
+		"alerts":      nt.Examples,
+	})
+}
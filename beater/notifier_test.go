@@ -0,0 +1,170 @@
+//go:build !integration
+// +build !integration
+
+package beater
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rcowham/p4dbeat/config"
+	"github.com/sirupsen/logrus"
+)
+
+// testWebhook records the notifications POSTed to it
+type testWebhook struct {
+	mu     sync.Mutex
+	server *httptest.Server
+	bodies []map[string]interface{}
+}
+
+func newTestWebhook(t *testing.T) *testWebhook {
+	w := &testWebhook{}
+	w.server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("invalid notification: %v", err)
+		}
+		w.mu.Lock()
+		w.bodies = append(w.bodies, body)
+		w.mu.Unlock()
+	}))
+	t.Cleanup(w.server.Close)
+	return w
+}
+
+func (w *testWebhook) notifications() []map[string]interface{} {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]map[string]interface{}{}, w.bodies...)
+}
+
+func newTestNotifier(t *testing.T, url string) *notifier {
+	log := logrus.New()
+	log.Out = ioutil.Discard
+	cfg := config.DefaultConfig.Notifier
+	cfg.Enabled = true
+	cfg.URL = url
+	return newNotifier(cfg, newTestStore(t), log)
+}
+
+func TestNotifierDedup(t *testing.T) {
+	start := time.Unix(1536228000, 0)
+	alert := func(id string) alertInfo {
+		return alertInfo{id: id, rule: "slow", severity: "warning", time: start, summary: id}
+	}
+	tests := []struct {
+		name      string
+		ids       []string
+		after     []time.Duration // since start
+		wantCount []int           // of the notifications, in order
+	}{
+		{"different alerts", []string{"a", "b"}, []time.Duration{0, time.Second}, []int{2}},
+		{"same alert", []string{"a", "a"}, []time.Duration{0, time.Second}, []int{1}},
+		{"same alert in later window", []string{"a", "b", "a"}, []time.Duration{0, 2 * time.Minute, 3 * time.Minute}, []int{1, 1}},
+		{"same alert after ttl", []string{"a", "a"}, []time.Duration{0, 25 * time.Hour}, []int{1, 1}},
+		{"no ids", []string{"", ""}, []time.Duration{0, time.Second}, []int{2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := newTestNotifier(t, "http://localhost")
+			for i, id := range tt.ids {
+				n.add(alert(id), start.Add(tt.after[i]))
+			}
+			if len(n.state.Pending) != len(tt.wantCount) {
+				t.Fatalf("%d notifications, want %d", len(n.state.Pending), len(tt.wantCount))
+			}
+			for i, want := range tt.wantCount {
+				if got := n.state.Pending[i].Count; got != want {
+					t.Errorf("notification %d has %d alerts, want %d", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestNotifierDedupRestart(t *testing.T) {
+	// Notified alert IDs are stored with the unsent notifications
+	n := newTestNotifier(t, "http://localhost")
+	now := time.Now()
+	n.add(alertInfo{id: "a", rule: "slow", time: now}, now)
+	n.state.Pending = nil // sent
+	n.save()
+	n2 := newNotifier(n.cfg, n.store, n.log)
+	n2.add(alertInfo{id: "a", rule: "slow", time: now}, now.Add(time.Hour))
+	if len(n2.state.Pending) != 0 {
+		t.Errorf("alert notified again after restart: %+v", n2.state.Pending)
+	}
+}
+
+func TestNotifierSend(t *testing.T) {
+	hook := newTestWebhook(t)
+	n := newTestNotifier(t, hook.server.URL)
+	now := time.Now()
+	for _, id := range []string{"a", "b", "a"} {
+		n.add(alertInfo{id: id, rule: "slow", severity: "critical", serverID: "p4d1", time: now, summary: id}, now)
+	}
+	done := make(chan struct{})
+	if n.sendDue(now, done) || len(hook.notifications()) != 0 {
+		t.Fatal("notification sent before the end of its window")
+	}
+	if !n.sendDue(now.Add(n.cfg.Window), done) {
+		t.Fatal("notification not sent at the end of its window")
+	}
+	got := hook.notifications()
+	if len(got) != 1 || got[0]["rule"] != "slow" || got[0]["count"] != float64(2) || got[0]["serverid"] != "p4d1" {
+		t.Errorf("notifications %v, want 1 for 2 alerts", got)
+	}
+	if len(n.state.Pending) != 0 {
+		t.Errorf("%d notifications still pending", len(n.state.Pending))
+	}
+}
+
+func TestNotifierFlush(t *testing.T) {
+	// Imports send notifications without waiting for their windows, including alerts still queued
+	hook := newTestWebhook(t)
+	n := newTestNotifier(t, hook.server.URL)
+	now := time.Now()
+	n.add(alertInfo{id: "a", rule: "slow", time: now}, now)
+	n.notify(alertInfo{id: "b", rule: "failed", time: now})
+	n.flush(make(chan struct{}))
+	if got := hook.notifications(); len(got) != 2 {
+		t.Errorf("%d notifications sent, want 2", len(got))
+	}
+	if len(n.state.Pending) != 0 {
+		t.Errorf("%d notifications still pending", len(n.state.Pending))
+	}
+}
+
+func TestNotifierRetry(t *testing.T) {
+	fail := int32(1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&fail) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	n := newTestNotifier(t, server.URL)
+	now := time.Now()
+	n.add(alertInfo{rule: "slow", time: now}, now)
+	due := now.Add(n.cfg.Window)
+	done := make(chan struct{})
+	n.sendDue(due, done)
+	if len(n.state.Pending) != 1 || n.state.Pending[0].Attempts != 1 {
+		t.Fatalf("failed notification not kept to retry: %+v", n.state.Pending)
+	}
+	atomic.StoreInt32(&fail, 0)
+	if n.sendDue(due, done) || len(n.state.Pending) != 1 {
+		t.Fatal("notification retried before backoff")
+	}
+	n.sendDue(due.Add(n.cfg.Backoff), done)
+	if len(n.state.Pending) != 0 {
+		t.Errorf("notification not sent on retry: %+v", n.state.Pending)
+	}
+}
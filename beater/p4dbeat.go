@@ -2,7 +2,8 @@ package beater
 
 import (
 	"context"
-	"encoding/json"
+	"crypto/sha1"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"strings"
@@ -23,16 +24,35 @@ import (
 
 const offsetKeyName = "offset"
 
+// How long Stop waits for Run to publish the events already read or accepted before the
+// client is closed, e.g. when the output is down
+const stopTimeout = 10 * time.Second
+
 // P4dbeat configuration.
 type P4dbeat struct {
-	done     chan struct{}
-	name     string
-	config   config.Config
-	client   beat.Client
-	lines    chan string
-	events   chan string
-	log      *logrus.Logger
-	registry *statestore.Registry
+	done       chan struct{}
+	stopped    chan struct{} // closed when Run returns
+	name       string
+	hostname   string // host the beat is running on
+	config     config.Config
+	loc        *time.Location      // timezone of p4d server
+	rollup     *commandRollup      // nil if rollups not enabled
+	latency    *latencySummary     // nil if percentiles not enabled
+	contention *contentionAnalyser // nil if contention analysis not enabled
+	alerts     []alertRule
+	notifier   *notifier       // nil if alert notifications not enabled
+	metrics    *commandMetrics // nil if the metrics endpoint is not enabled
+	ips        *ipEnricher     // nil if sites/GeoIP not configured
+	users      *userDirectory  // nil if no user files configured
+	privacy    *pseudonymiser  // nil if all personal data is kept
+	filters    *commandFilters // nil if all commands are published
+	sampler    *commandSampler // nil if commands are not sampled
+	tables     *tableFilter    // nil if all tables are published
+	onPublish  func()          // called before each event is published
+	client     beat.Client
+	events     chan string
+	log        *logrus.Logger
+	registry   *statestore.Registry
 }
 
 // New creates an instance of p4dbeat.
@@ -57,15 +77,53 @@ func New(b *beat.Beat, cfg *common.Config) (beat.Beater, error) {
 		return nil, err
 	}
 
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
 	bt := &P4dbeat{
 		done:     make(chan struct{}),
-		lines:    make(chan string, 100),
+		stopped:  make(chan struct{}),
 		events:   make(chan string, 100),
 		name:     b.Info.Name,
+		hostname: b.Info.Hostname,
 		config:   c,
+		loc:      loc,
 		log:      log,
 		registry: statestore.NewRegistry(memlog),
 	}
+	if c.Rollup.Enabled {
+		bt.rollup = newCommandRollup(c.Period)
+	}
+	if c.Percentiles.Enabled {
+		bt.latency = newLatencySummary(c.Percentiles.Interval, c.Percentiles.Percentiles)
+	}
+	if c.Contention.Enabled {
+		bt.contention = newContentionAnalyser(c.Contention)
+	}
+	if c.Metrics.Enabled {
+		bt.metrics = newCommandMetrics(c.Metrics)
+	}
+	bt.users = newUserDirectory(c.Users, log)
+	if bt.ips, err = newIPEnricher(c.Sites, c.GeoIP); err != nil {
+		return nil, err
+	}
+	if bt.filters, err = newCommandFilters(c.Filters); err != nil {
+		return nil, err
+	}
+	if bt.sampler, err = newCommandSampler(c.Sampling, log); err != nil {
+		return nil, err
+	}
+	if bt.tables, err = newTableFilter(c.Tables); err != nil {
+		return nil, err
+	}
+	if bt.privacy, err = newPseudonymiser(c.Privacy); err != nil {
+		return nil, err
+	}
+	if bt.alerts, err = newAlertRules(c.Alerts); err != nil {
+		return nil, err
+	}
 
 	return bt, nil
 }
@@ -73,6 +131,13 @@ func New(b *beat.Beat, cfg *common.Config) (beat.Beater, error) {
 // Run starts p4dbeat.
 func (bt *P4dbeat) Run(b *beat.Beat) error {
 	logp.Info("p4dbeat is running! Hit CTRL-C to stop it.")
+	defer close(bt.stopped)
+	// The registry waits for all stores to be closed, so it has to be closed after store below
+	defer bt.registry.Close()
+
+	if len(bt.config.Import.Files) > 0 {
+		return bt.runImport(b)
+	}
 
 	store, err := bt.registry.Get("p4dbeat")
 	if err != nil {
@@ -80,60 +145,156 @@ func (bt *P4dbeat) Run(b *beat.Beat) error {
 	}
 	defer store.Close()
 
-	// Load the stored offset from the state registry so
-	// we resume parsing the file where we left off.
-	// Note: if a rotation happens in between we will resume at
-	// the wrong place, we need to store the inode value to detect that
-	offsetData := common.MapStr{}
-	var offset int64
-	err = store.Get(offsetKeyName, &offsetData)
+	if bt.config.Notifier.Enabled {
+		defer bt.startNotifier(store)()
+	}
+
+	inputs, err := bt.expandInputs(true)
 	if err != nil {
-		bt.log.Warnf("No file offset state found, resuming from the start: %v", err)
-	} else if offsetData["offset"] != "" {
-		offsetVal, ok := offsetData["offset"].(float64) // all json values are float64
-		if !ok {
-			bt.log.Warnf("Invalid file offset: '%s': %v", offsetData["offset"], err)
-		} else {
-			offset = int64(offsetVal)
-			bt.log.Infof("Starting at offset %d bytes", offset)
-		}
+		return err
+	}
+	if len(inputs) == 0 {
+		bt.log.Warnf("No log files found for the inputs - waiting for them to appear")
 	}
 
-	bt.client, err = b.Publisher.Connect()
+	// Offsets are only stored once the events have been acknowledged by the output
+	bt.client, err = b.Publisher.ConnectWith(beat.ClientConfig{
+		PublishMode: beat.GuaranteedSend,
+		ACKHandler:  bt.ackOffsets(store),
+	})
 	if err != nil {
 		return err
 	}
 
-	logp.Debug("Processing log file: %s\n", bt.config.Path)
+	if bt.users != nil {
+		go bt.users.run(bt.done)
+	}
 
-	tailFileDone := make(chan struct{})
-	tailFileconfig := tail.Config{
-		ReOpen:      true,
-		MustExist:   false,
-		Poll:        false,
-		Follow:      true,
-		MaxLineSize: 0,
-		Location: &tail.SeekInfo{
-			Offset: offset,
-			Whence: os.SEEK_SET,
-		},
+	stopJSONInput, err := bt.startJSONInput()
+	if err != nil {
+		return err
 	}
+	defer stopJSONInput()
 
-	go bt.tailFile(bt.config.Path, tailFileconfig, tailFileDone, bt.done, store)
+	if bt.metrics != nil {
+		stopMetrics, err := bt.startMetrics()
+		if err != nil {
+			return err
+		}
+		defer stopMetrics()
+	}
 
-	<-tailFileDone
+	// Inputs are tailed until we are stopped. Globs are re-expanded each scan so new logs are
+	// picked up, and inputs which stopped with an error are retried.
+	tailFileDone := make(chan *logInput)
+	tailing := make(map[string]bool) // by state key
+	startInputs := func(inputs []*logInput) {
+		for _, in := range inputs {
+			if tailing[in.stateKey] {
+				continue
+			}
+			tailing[in.stateKey] = true
+			logp.Debug("Processing log file: %s\n", in.path)
+			go bt.tailFile(in, tailFileDone, bt.done, store)
+		}
+	}
+	startInputs(inputs)
+	scanTicker := time.NewTicker(bt.config.ScanFrequency)
+	defer scanTicker.Stop()
+
+	// Rollups are published each period once it has ended
+	var rollupTick <-chan time.Time
+	if bt.rollup != nil {
+		ticker := time.NewTicker(bt.rollup.period)
+		defer ticker.Stop()
+		rollupTick = ticker.C
+	}
+	// and so are latency percentiles each interval
+	var latencyTick <-chan time.Time
+	if bt.latency != nil {
+		ticker := time.NewTicker(bt.latency.interval)
+		defer ticker.Stop()
+		latencyTick = ticker.C
+	}
+	var contentionTick <-chan time.Time
+	if bt.contention != nil {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		contentionTick = ticker.C
+	}
+
+	stop := bt.done
+	for stop != nil || len(tailing) > 0 {
+		select {
+		case <-stop:
+			stop = nil // wait for the inputs to finish
+		case in := <-tailFileDone:
+			delete(tailing, in.stateKey)
+		case <-scanTicker.C:
+			if stop == nil {
+				continue
+			}
+			if inputs, err := bt.expandInputs(false); err != nil {
+				bt.log.Warnf("Failed to expand inputs: %v", err)
+			} else {
+				startInputs(inputs)
+			}
+		case json := <-bt.events:
+			bt.publishEvent(json)
+		case now := <-rollupTick:
+			bt.publishRollups(bt.rollup.take(now))
+		case now := <-latencyTick:
+			bt.publishPercentiles(bt.latency.take(now))
+		case <-contentionTick:
+			bt.publishContention(bt.contention.analyse(false))
+		}
+	}
+	// Records accepted by the JSON input are published before the client is closed
+	stopJSONInput()
+	bt.processEvents()
 
 	return nil
 }
 
-// ticker := time.NewTicker(bt.config.Period)
-// counter := 1
-// for {
-// 	select {
-// 	case <-bt.done:
-// 		return nil
-// 	case <-ticker.C:
-// 	}
+// startNotifier starts sending alert notifications, returning a function to stop it
+func (bt *P4dbeat) startNotifier(store *statestore.Store) func() {
+	bt.notifier = newNotifier(bt.config.Notifier, store, bt.log)
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		bt.notifier.run(stop)
+	}()
+	return func() {
+		close(stop)
+		<-done
+	}
+}
+
+// resumeInput loads the stored state from the state registry so we resume parsing
+// the file where we left off - or finish off the previous file
+// if it has been rotated in the meantime. The returned state is empty if starting
+// from the beginning.
+func (bt *P4dbeat) resumeInput(in *logInput, store *statestore.Store) (rotated string, resume fileState) {
+	st, ok, err := loadState(store, in.stateKey)
+	if err != nil {
+		bt.log.Warnf("Invalid file offset state for '%s', resuming from the start: %v", in.path, err)
+	} else if !ok {
+		bt.log.Warnf("No file offset state found for '%s', resuming from the start", in.path)
+	} else if rotated, ok = bt.resumePosition(in.path, in.rotatedPattern, st); ok {
+		if rotated == "" {
+			bt.log.Infof("Starting '%s' at offset %d bytes (line %d)", in.path, st.Offset, st.LineNo)
+		}
+		resume = st
+	}
+	return rotated, resume
+}
+
+func setIfNotEmpty(event *beat.Event, key, value string) {
+	if value != "" {
+		event.Fields[key] = value
+	}
+}
 
 func setIfNonZero(event *beat.Event, fieldName string, value int64) {
 	if value > 0 {
@@ -160,9 +321,93 @@ func setTblIfNonZeroMs(event *beat.Event, tableName string, fieldName string, va
 	}
 }
 
-func (bt *P4dbeat) publishCommand(command p4dlog.Command) {
+// commandID returns a document ID for the command which is the same every time the log is read,
+// so re-reading a log after a restart, or importing it, doesn't create duplicates
+func commandID(serverID string, command *p4dlog.Command) string {
+	h := sha1.New()
+	fmt.Fprintf(h, "%s|%s|%s|%d|%d", serverID, command.ProcessKey,
+		command.StartTime.Format(time.RFC3339), command.Pid, command.LineNo)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// splitIP returns the client and proxy IPs from the IP of a command, which is "proxy/client"
+// for commands via a proxy or broker
+func splitIP(commandIP string) (string, string) {
+	ips := strings.Split(commandIP, "/")
+	if len(ips) > 1 {
+		return ips[1], ips[0]
+	}
+	if ips[0] == "background" {
+		return "", ""
+	}
+	return ips[0], ""
+}
+
+// publishCommand publishes the command as an event. private is passed back
+// to the ACK handler once the event has been acknowledged.
+func (bt *P4dbeat) publishCommand(in *logInput, command p4dlog.Command, private interface{}) {
+	// The ID is from the wall clock time in the log, so it doesn't depend on the timezone or
+	// which side of a DST change the command was resolved to
+	id := ""
+	if bt.config.DeterministicID {
+		id = commandID(in.serverID(), &command)
+	}
+	in.localiseTimes(&command)
+	timestamp := bt.eventTimestamp(command.StartTime, command.EndTime)
+	raw := command // for enrichment and alerts, which use the values before they're pseudonymised
+	if bt.privacy != nil {
+		bt.privacy.command(&command)
+	}
+	if bt.rollup != nil {
+		bt.rollup.add(in, timestamp, &command)
+	}
+	if bt.latency != nil {
+		bt.latency.add(in, timestamp, &command)
+	}
+	if bt.contention != nil {
+		bt.contention.add(in, &command)
+	}
+	if bt.metrics != nil {
+		bt.metrics.observe(in.serverID(), &command)
+	}
+	// Sampled after the rollups etc. so they still include every command, and alerts are
+	// checked for every command whether it is sampled or not
+	sampleRate, keep := 1, true
+	if bt.sampler != nil {
+		sampleRate, keep = bt.sampler.sample(&command)
+	}
+	if !keep && len(bt.alerts) == 0 {
+		return
+	}
+	event := bt.commandEvent(in, &command, raw.User, timestamp, private)
+	if id != "" {
+		event.SetID(id)
+	}
+	if bt.privacy != nil {
+		bt.privacy.event(&event)
+	}
+	if keep {
+		if bt.sampler != nil {
+			event.Fields["p4.sample_rate"] = sampleRate
+		}
+		published := event
+		published.Fields = ecsCommand(bt.config.Schema, in.hostname, event.Fields)
+		bt.publish(published)
+	}
+	if len(bt.alerts) > 0 {
+		values := event.Fields
+		if bt.privacy != nil {
+			values = bt.commandEvent(in, &raw, raw.User, timestamp, nil).Fields
+		}
+		bt.checkAlerts(in, &event, values)
+	}
+}
+
+// commandEvent returns the event for the command. user is the user for enrichment.
+func (bt *P4dbeat) commandEvent(in *logInput, command *p4dlog.Command, user string, timestamp time.Time, private interface{}) beat.Event {
 	event := beat.Event{
-		Timestamp: time.Now(),
+		Timestamp: timestamp,
+		Private:   private,
 		Fields: common.MapStr{
 			"type":                bt.name,
 			"p4.process_key":      command.ProcessKey,
@@ -200,22 +445,24 @@ func (bt *P4dbeat) publishCommand(command p4dlog.Command) {
 	setIfNonZeroSec(&event, "rpc.snd_sec", command.RPCSnd)
 	setIfNonZeroSec(&event, "rpc.rcv_sec", command.RPCRcv)
 
-	ips := strings.Split(command.IP, "/")
-	if len(ips) == 1 {
-		if ips[0] != "background" && ips[0] != "" {
-			event.Fields["p4.ip"] = ips[0]
-		}
-	} else if len(ips) > 1 {
-		if ips[0] != "" {
-			event.Fields["p4.proxy_ip"] = ips[0]
-		}
-		if ips[1] != "" {
-			event.Fields["p4.ip"] = ips[1]
-		}
+	ip, proxyIP := splitIP(command.IP)
+	setIfNotEmpty(&event, "p4.ip", ip)
+	setIfNotEmpty(&event, "p4.proxy_ip", proxyIP)
+	if bt.ips != nil {
+		bt.ips.enrich(&event, "", ip)
+		bt.ips.enrich(&event, "proxy_", proxyIP)
+	}
+	if bt.users != nil {
+		bt.users.enrich(&event, user)
 	}
+	setAppFields(&event, command.App)
+	setArgFields(&event, command.Cmd, command.Args)
 
 	for _, values := range command.Tables {
-		// note: these do not exist in fields.yml but will be auto-discovered as numbers
+		if bt.tables != nil && !bt.tables.publish(values.TableName) {
+			continue
+		}
+		// note: these are mapped by the p4.tbl.* dynamic templates in fields.yml - *_sec as float, others as long
 		setTblIfNonZero(&event, values.TableName, "pages.in", values.PagesIn)
 		setTblIfNonZero(&event, values.TableName, "pages.out", values.PagesOut)
 		setTblIfNonZero(&event, values.TableName, "pages.cached", values.PagesCached)
@@ -243,32 +490,58 @@ func (bt *P4dbeat) publishCommand(command p4dlog.Command) {
 		setTblIfNonZeroMs(&event, values.TableName, "peek.held.max_sec", values.MaxPeekHeld)
 	}
 
+	for k, v := range in.fields {
+		event.Fields[k] = v
+	}
+	return event
+}
+
+// publish sends the event to the output
+func (bt *P4dbeat) publish(event beat.Event) {
+	if bt.onPublish != nil {
+		bt.onPublish()
+	}
 	bt.client.Publish(event)
 }
 
+// publishEvent publishes a command-like JSON record, e.g. from the JSON input
 func (bt *P4dbeat) publishEvent(str string) {
-	var f interface{}
-	err := json.Unmarshal([]byte(str), &f)
+	rec, err := decodeJSONRecord([]byte(str), bt.loc)
 	if err != nil {
 		bt.log.Warnf("Error %v to unmarshal %s", err, str)
+		return
 	}
-	m := f.(map[string]interface{})
 	event := beat.Event{
-		Timestamp: time.Now(),
+		Timestamp: bt.eventTimestamp(rec.startTime, rec.endTime),
 		Fields: common.MapStr{
-			"type":             bt.name,
-			"p4.cmd":           m["cmd"],
-			"p4.user":          m["user"],
-			"p4.workspace":     m["workspace"],
-			"p4.ip":            m["ip"],
-			"p4.args":          m["args"],
-			"p4.start_time":    m["startTime"],
-			"p4.end_time":      m["endTime"],
-			"p4.compute_sec":   m["computeLapse"],
-			"p4.completed_sec": m["completedLapse"],
+			"type": bt.name,
 		},
 	}
-	bt.client.Publish(event)
+	setIfNotEmpty(&event, "p4.cmd", rec.cmd)
+	setIfNotEmpty(&event, "p4.user", rec.user)
+	setIfNotEmpty(&event, "p4.workspace", rec.workspace)
+	setIfNotEmpty(&event, "p4.ip", rec.ip)
+	setIfNotEmpty(&event, "p4.args", rec.args)
+	if !rec.startTime.IsZero() {
+		event.Fields["p4.start_time"] = rec.startTime
+	}
+	if !rec.endTime.IsZero() {
+		event.Fields["p4.end_time"] = rec.endTime
+	}
+	if rec.computeLapse != nil {
+		event.Fields["p4.compute_sec"] = *rec.computeLapse
+	}
+	if rec.completedLapse != nil {
+		event.Fields["p4.completed_sec"] = *rec.completedLapse
+	}
+	if bt.privacy != nil {
+		bt.privacy.record(&event)
+	}
+	for k, v := range bt.config.JSONInput.Fields.Flatten() {
+		event.Fields[k] = v
+	}
+	event.Fields = ecsCommand(bt.config.Schema, "", event.Fields)
+	bt.publish(event)
 }
 
 func (bt *P4dbeat) processEvents() {
@@ -282,47 +555,140 @@ func (bt *P4dbeat) processEvents() {
 	}
 }
 
-func (bt *P4dbeat) tailFile(filename string, config tail.Config, done chan struct{}, stop chan struct{}, store *statestore.Store) {
+// tailConfig returns the config to tail a log from the specified offset.
+// If follow is false we stop at EOF (for finishing rotated logs).
+func tailConfig(offset int64, follow bool) tail.Config {
+	return tail.Config{
+		ReOpen:      follow,
+		MustExist:   !follow,
+		Poll:        false,
+		Follow:      follow,
+		MaxLineSize: 0,
+		Location: &tail.SeekInfo{
+			Offset: offset,
+			Whence: os.SEEK_SET,
+		},
+	}
+}
+
+// tailFile parses and publishes the log for the input. If the log was rotated while we were
+// stopped, then the rotated file is read from the saved offset to EOF first, and the
+// current log is then read from the start.
+func (bt *P4dbeat) tailFile(in *logInput, done chan *logInput, stop chan struct{}, store *statestore.Store) {
 	ctx := context.Background()
 
 	defer func() {
-		done <- struct{}{}
+		done <- in
 	}()
 
-	t, err := tail.TailFile(filename, config)
+	filename := in.path
+	rotated, resume := bt.resumeInput(in, store)
+	current := filename
+	tc := tailConfig(resume.Offset, true)
+	if rotated != "" {
+		current = rotated
+		tc = tailConfig(resume.Offset, false)
+	}
+	t, err := tail.TailFile(current, tc)
 	if err != nil {
 		logp.Err("Start tail file failed, err: %v", err)
 		return
 	}
+	st, err := newFileState(current)
+	if err != nil {
+		bt.log.Warnf("Failed to read identity of '%s': %v", current, err)
+	}
+	pos := newLogPosition(resume.Offset, resume.LineNo)
 
-	fp := p4dlog.NewP4dFileParser(bt.log)
-	commands := fp.LogParser(ctx, bt.lines, nil)
+	// Structured and audit logs have one record per line so don't need the parser
+	var commands chan p4dlog.Command
+	if in.parser == config.ParserP4dLog {
+		fp := p4dlog.NewP4dFileParser(bt.log)
+		commands = fp.LogParser(ctx, in.lines, nil)
+	}
+	// Rolled up audit records are published once idle
+	var idle <-chan time.Time
+	if in.audit != nil {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		idle = ticker.C
+	}
 
-	bt.log.Infof("Log parser is now tailing '%s'", filename)
+	bt.log.Infof("Log parser is now tailing '%s'", current)
 	for {
 		select {
 		case <-stop:
 			bt.log.Debug("Stopping\n", "")
-			close(bt.lines)
-			bt.processEvents()
+			close(in.lines)
 			t.Stop()
 			return
-		case line := <-t.Lines:
+		case line, ok := <-t.Lines:
+			if !ok {
+				if current == filename {
+					logp.Err("Tail file stopped, err: %v", t.Err())
+					return
+				}
+				// Finished the rotated log so carry on with the current one from the start.
+				// Commands still open in the rotated log can't be resumed from the new one.
+				bt.log.Infof("Finished rotated log '%s'", current)
+				bt.flushAudit(in, pos, &st, true)
+				t.Stop()
+				t.Cleanup()
+				current = filename
+				if t, err = tail.TailFile(current, tailConfig(0, true)); err != nil {
+					logp.Err("Start tail file failed, err: %v", err)
+					return
+				}
+				st = fileState{Source: current}
+				resume = fileState{}
+				pos.reset()
+				bt.log.Infof("Log parser is now tailing '%s'", current)
+				continue
+			}
+			// If the file has been rotated or truncated, tail reopens it and we start again from 0.
+			// Tell is past the end of this line otherwise (it returns 0 while reopening).
+			if offset, err := t.Tell(); err == nil && offset > 0 && offset < pos.offset {
+				bt.log.Infof("Log '%s' has been replaced, now reading from the start", current)
+				bt.flushAudit(in, pos, &st, true)
+				st.updateIdentity()
+				resume = fileState{}
+				pos.reset()
+			}
 			bt.log.Debugf("Parsing line:\n%s", line.Text)
-			bt.lines <- line.Text
+			if in.parser == config.ParserP4Audit {
+				bt.auditLine(in, line.Text, pos, &st)
+				continue
+			}
+			pos.addLine(line.Text)
+			if commands == nil {
+				pos.update(&st)
+				bt.publishStructured(in, line.Text, pos.lineNo-1, offsetUpdate{key: in.stateKey, state: st})
+				continue
+			}
+			in.lines <- line.Text
+
+		case <-idle:
+			bt.flushAudit(in, pos, &st, false)
 
 		case command := <-commands:
-			bt.log.Debugf("Publishing '%s' command", command.Cmd)
-			bt.publishCommand(command)
-			// update the offset for every parsed command
-			offset, err := t.Tell()
-			if err != nil {
-				bt.log.Errorf("Failed to get current log offset")
-			} else {
-				store.Set(offsetKeyName, common.MapStr{"offset": offset})
+			var current bool
+			command.LineNo, current = pos.absLineNo(command.LineNo)
+			if current {
+				pos.commandOutput(command.Pid, command.LineNo)
 			}
-		case json := <-bt.events:
-			bt.publishEvent(json)
+			// Commands skipped here aren't added to rollups or percentiles either, which are
+			// only kept in memory
+			if current && resume.alreadyPublished(command.LineNo) {
+				bt.log.Debugf("Skipping '%s' command at line %d as already published", command.Cmd, command.LineNo)
+				continue
+			}
+			if bt.filters != nil && !bt.filters.publish(&command) {
+				continue
+			}
+			// update the resume position for every parsed command - it is stored when the event is acknowledged
+			pos.update(&st)
+			bt.log.Debugf("Publishing '%s' command", command.Cmd)
+			bt.publishCommand(in, command, offsetUpdate{key: in.stateKey, state: st})
 		}
 	}
 
@@ -332,8 +698,15 @@ func (bt *P4dbeat) tailFile(filename string, config tail.Config, done chan struc
 }
 
 // Stop stops p4dbeat.
+// Run is given stopTimeout to finish publishing before the client is closed.
 func (bt *P4dbeat) Stop() {
-	bt.client.Close()
-	bt.registry.Close()
 	close(bt.done)
+	select {
+	case <-bt.stopped:
+	case <-time.After(stopTimeout):
+		bt.log.Warnf("Timed out waiting for events to be published, stopping anyway")
+	}
+	if bt.client != nil {
+		bt.client.Close()
+	}
 }
@@ -0,0 +1,184 @@
+package beater
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	p4dlog "github.com/rcowham/go-libp4dlog"
+)
+
+// Relative accuracy of the percentiles from the sketch
+const sketchAccuracy = 0.01
+
+// Values below this (in seconds) are counted as zero
+const sketchMinValue = 1e-6
+
+// latencySketch is a streaming quantile sketch with logarithmic buckets (as per DDSketch), so that
+// any quantile is within sketchAccuracy of the true value, using memory proportional to the
+// range of values rather than the number of them.
+type latencySketch struct {
+	gamma   float64
+	buckets map[int]int64
+	zeros   int64
+	count   int64
+	max     float64
+	sum     float64
+}
+
+func newLatencySketch() *latencySketch {
+	return &latencySketch{
+		gamma:   (1 + sketchAccuracy) / (1 - sketchAccuracy),
+		buckets: make(map[int]int64),
+	}
+}
+
+func (s *latencySketch) add(v float64) {
+	s.count++
+	s.sum += v
+	if v > s.max {
+		s.max = v
+	}
+	if v < sketchMinValue {
+		s.zeros++
+		return
+	}
+	s.buckets[int(math.Ceil(math.Log(v)/math.Log(s.gamma)))]++
+}
+
+// quantile returns the value at quantile q (0-1)
+func (s *latencySketch) quantile(q float64) float64 {
+	if s.count == 0 {
+		return 0
+	}
+	if q >= 1 {
+		return s.max
+	}
+	rank := int64(q * float64(s.count-1))
+	if rank < s.zeros {
+		return 0
+	}
+	keys := make([]int, 0, len(s.buckets))
+	for k := range s.buckets {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	n := s.zeros
+	for _, k := range keys {
+		n += s.buckets[k]
+		if n > rank {
+			// middle of the bucket (gamma^(k-1), gamma^k]
+			v := 2 * math.Pow(s.gamma, float64(k)) / (s.gamma + 1)
+			return math.Min(v, s.max)
+		}
+	}
+	return s.max
+}
+
+type latencyKey struct {
+	start  time.Time // start of the interval
+	fields string    // static fields of the input (see staticFieldsKey)
+	cmd    string
+}
+
+type latencyStats struct {
+	fields    common.MapStr // static fields of the input
+	completed *latencySketch
+	compute   *latencySketch
+}
+
+// latencySummary keeps sketches of the completed and compute lapse times of each command type
+// by interval (of the event timestamp), as per commandRollup. Commands are added from each
+// input's goroutine.
+type latencySummary struct {
+	mu          sync.Mutex
+	interval    time.Duration
+	percentiles []float64
+	stats       map[latencyKey]*latencyStats
+}
+
+// Percentiles published if none are configured. Not set in config.DefaultConfig, as a configured
+// list would be merged index-wise with it, e.g. [90] giving [90, 95, 99].
+var defaultPercentiles = []float64{50, 95, 99}
+
+func newLatencySummary(interval time.Duration, percentiles []float64) *latencySummary {
+	if len(percentiles) == 0 {
+		percentiles = defaultPercentiles
+	}
+	return &latencySummary{
+		interval:    interval,
+		percentiles: percentiles,
+		stats:       make(map[latencyKey]*latencyStats),
+	}
+}
+
+// add adds the command to the sketches for its timestamp
+func (l *latencySummary) add(in *logInput, timestamp time.Time, command *p4dlog.Command) {
+	key := latencyKey{start: timestamp.Truncate(l.interval), fields: in.fieldsKey, cmd: command.Cmd}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	st, ok := l.stats[key]
+	if !ok {
+		st = &latencyStats{fields: in.fields, completed: newLatencySketch(), compute: newLatencySketch()}
+		l.stats[key] = st
+	}
+	st.completed.add(float64(command.CompletedLapse))
+	st.compute.add(float64(command.ComputeLapse))
+}
+
+// take removes and returns the sketches for intervals which ended before the specified time,
+// or all of them if it is zero
+func (l *latencySummary) take(before time.Time) map[latencyKey]*latencyStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	ended := make(map[latencyKey]*latencyStats)
+	for k, st := range l.stats {
+		if before.IsZero() || !k.start.Add(l.interval).After(before) {
+			ended[k] = st
+			delete(l.stats, k)
+		}
+	}
+	return ended
+}
+
+// percentileName returns the field name for the percentile, e.g. p95 or p99_9
+func percentileName(p float64) string {
+	return "p" + strings.Replace(strconv.FormatFloat(p, 'f', -1, 64), ".", "_", 1)
+}
+
+func setPercentiles(event *beat.Event, prefix string, s *latencySketch, percentiles []float64) {
+	for _, p := range percentiles {
+		event.Fields[fmt.Sprintf("%s.%s", prefix, percentileName(p))] = s.quantile(p / 100)
+	}
+	event.Fields[prefix+".max"] = s.max
+	event.Fields[prefix+".avg"] = s.sum / float64(s.count)
+}
+
+// publishPercentiles publishes a summary event for each command type and interval taken from
+// the latency summary. Commands which arrive after their interval has been published are
+// published in a further event for the same interval.
+func (bt *P4dbeat) publishPercentiles(stats map[latencyKey]*latencyStats) {
+	for k, st := range stats {
+		event := beat.Event{
+			Timestamp: k.start,
+			Fields: common.MapStr{
+				"type":                    bt.name,
+				"p4.cmd":                  k.cmd,
+				"p4.latency.interval_sec": bt.latency.interval.Seconds(),
+				"p4.latency.count":        st.completed.count,
+			},
+		}
+		setPercentiles(&event, "p4.latency.completed_sec", st.completed, bt.latency.percentiles)
+		setPercentiles(&event, "p4.latency.compute_sec", st.compute, bt.latency.percentiles)
+		for k, v := range st.fields {
+			event.Fields[k] = v
+		}
+		bt.publish(event)
+	}
+}
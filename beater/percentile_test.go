@@ -0,0 +1,95 @@
+//go:build !integration
+// +build !integration
+
+package beater
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	p4dlog "github.com/rcowham/go-libp4dlog"
+)
+
+func TestLatencySketchAccuracy(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	tests := []struct {
+		name  string
+		value func() float64
+	}{
+		{"uniform", func() float64 { return r.Float64() * 100 }},
+		{"lognormal", func() float64 { return math.Exp(r.NormFloat64() * 2) }},
+		{"exponential", func() float64 { return r.ExpFloat64() }},
+		{"with zeros", func() float64 {
+			if r.Intn(4) == 0 {
+				return 0
+			}
+			return r.Float64() * 10
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newLatencySketch()
+			values := make([]float64, 10000)
+			for i := range values {
+				values[i] = tt.value()
+				s.add(values[i])
+			}
+			sort.Float64s(values)
+			for _, q := range []float64{0, 0.1, 0.5, 0.9, 0.95, 0.99, 0.999, 1} {
+				exact := values[int(q*float64(len(values)-1))]
+				got := s.quantile(q)
+				if math.Abs(got-exact) > exact*sketchAccuracy {
+					t.Errorf("quantile(%v) = %v, exact %v", q, got, exact)
+				}
+			}
+			if s.count != int64(len(values)) || s.max != values[len(values)-1] {
+				t.Errorf("count %d max %v, want %d %v", s.count, s.max, len(values), values[len(values)-1])
+			}
+		})
+	}
+}
+
+func TestLatencySketchEmpty(t *testing.T) {
+	if q := newLatencySketch().quantile(0.5); q != 0 {
+		t.Errorf("quantile of empty sketch = %v, want 0", q)
+	}
+}
+
+func TestLatencySummaryTake(t *testing.T) {
+	// Commands are summarised by interval of their timestamp, and commands arriving after their
+	// interval has been taken start a new summary for it rather than being merged into another
+	l := newLatencySummary(time.Minute, nil)
+	start := time.Date(2018, 9, 2, 10, 0, 0, 0, time.UTC)
+	in := &logInput{}
+	add := func(sec int, completed float32) {
+		l.add(in, start.Add(time.Duration(sec)*time.Second), &p4dlog.Command{Cmd: "user-sync", CompletedLapse: completed})
+	}
+	add(10, 1)
+	add(50, 3)
+	add(70, 5)
+	ended := l.take(start.Add(time.Minute))
+	if len(ended) != 1 {
+		t.Fatalf("%d intervals taken, want 1", len(ended))
+	}
+	st := ended[latencyKey{start: start, cmd: "user-sync"}]
+	if st == nil || st.completed.count != 2 || st.completed.max != 3 || st.completed.sum != 4 {
+		t.Fatalf("first interval %+v, want 2 commands", st)
+	}
+	add(20, 2) // late
+	ended = l.take(time.Time{})
+	if len(ended) != 2 {
+		t.Fatalf("%d intervals taken, want 2", len(ended))
+	}
+	if st := ended[latencyKey{start: start, cmd: "user-sync"}]; st == nil || st.completed.count != 1 || st.completed.max != 2 {
+		t.Errorf("late command summary %+v, want just that command", st)
+	}
+	if st := ended[latencyKey{start: start.Add(time.Minute), cmd: "user-sync"}]; st == nil || st.completed.count != 1 {
+		t.Errorf("second interval %+v, want 1 command", st)
+	}
+	if len(l.take(time.Time{})) != 0 {
+		t.Error("intervals left after taking all")
+	}
+}
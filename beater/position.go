@@ -0,0 +1,167 @@
+package beater
+
+import (
+	"hash/fnv"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Matches the start of a command line in the log, e.g.
+//
+//	2015/09/02 15:23:09 pid 1616 robert@robert-test 127.0.0.1 [p4/2016.2/LINUX26X86_64/1468155] 'user-sync //...'
+var reCmdStart = regexp.MustCompile(`^\t\d\d\d\d/\d\d/\d\d \d\d:\d\d:\d\d pid (\d+) [^ @]*@[^ ]* [^ ]* \[.*?\] '`)
+
+const infoBlock = "Perforce server info:"
+
+// Lines which start a new block in the log (as per p4dlog)
+var blockEnds = map[string]bool{
+	"":                                 true,
+	infoBlock:                          true,
+	"Perforce server error:":           true,
+	"locks acquired by blocking after": true,
+	"Rpc himark:":                      true,
+	"server to client":                 true,
+}
+
+// openCommand is the start of a command which has not yet been output by the parser
+type openCommand struct {
+	key    uint64 // hash of the command line - repeated for track records of the same command
+	lineNo int64
+	offset int64
+}
+
+// logPosition tracks the file position of the lines fed to the parser, and the start of
+// each command still open in the parser. Commands for concurrent pids interleave in the log
+// and may span many lines, so after a restart we need to re-read from the start of the oldest
+// command still open rather than from the current read position.
+// It mirrors the parser in keeping one command per pid.
+type logPosition struct {
+	offset      int64 // offset of the next line
+	lineNo      int64 // absolute line number of the next line
+	baseLineNo  int64 // absolute line number of the first line fed to the parser
+	blockLineNo int64 // start of current info block, 0 if not in one
+	blockOffset int64
+	open        map[int64]openCommand // by pid
+	// The parser carries on counting lines when the file is replaced, and may output commands
+	// from any of the previous files (e.g. when their pid is next seen)
+	replaced []replacedFile // oldest first
+}
+
+// replacedFile is a file which was replaced while tailing it
+type replacedFile struct {
+	lastLineNo int64 // parser line number of its last line
+	baseLineNo int64 // as logPosition.baseLineNo
+}
+
+func newLogPosition(offset, lineNo int64) *logPosition {
+	if lineNo < 1 {
+		lineNo = 1
+	}
+	return &logPosition{
+		offset:     offset,
+		lineNo:     lineNo,
+		baseLineNo: lineNo,
+		open:       make(map[int64]openCommand),
+	}
+}
+
+// addLine records a line about to be fed to the parser
+func (p *logPosition) addLine(line string) {
+	text := strings.TrimRight(line, "\r")
+	if blockEnds[text] {
+		p.blockLineNo = 0
+		if text == infoBlock {
+			p.blockLineNo = p.lineNo
+			p.blockOffset = p.offset
+		}
+	} else if p.blockLineNo > 0 {
+		if m := reCmdStart.FindStringSubmatch(text); len(m) > 0 {
+			p.startCommand(toInt64(m[1]), text)
+		}
+	}
+	p.offset += int64(len(line)) + 1 // newline is stripped by tail
+	p.lineNo++
+}
+
+// startCommand records a command start line. Track records repeat the same line, so these
+// are ignored, but a different command for the same pid replaces the previous one
+// (the parser outputs the previous one in that case).
+func (p *logPosition) startCommand(pid int64, line string) {
+	// Trigger lines are the same command as far as the parser is concerned
+	if i := strings.Index(line, "' trigger "); i >= 0 {
+		line = line[:i+1]
+	}
+	h := fnv.New64a()
+	h.Write([]byte(line))
+	key := h.Sum64()
+	if c, ok := p.open[pid]; ok && c.key == key {
+		return
+	}
+	p.open[pid] = openCommand{key: key, lineNo: p.blockLineNo, offset: p.blockOffset}
+}
+
+// commandOutput records that the parser has output the command for pid starting at lineNo (absolute)
+func (p *logPosition) commandOutput(pid, lineNo int64) {
+	if c, ok := p.open[pid]; ok && c.lineNo <= lineNo {
+		delete(p.open, pid)
+	}
+}
+
+// absLineNo converts a line number from the parser (which counts from 1) to the line number in the file.
+// current is false for commands in a file which has since been replaced, which are numbered as in that file.
+func (p *logPosition) absLineNo(parserLineNo int64) (lineNo int64, current bool) {
+	i := sort.Search(len(p.replaced), func(i int) bool { return p.replaced[i].lastLineNo >= parserLineNo })
+	if i < len(p.replaced) {
+		return p.replaced[i].baseLineNo + parserLineNo - 1, false
+	}
+	return p.baseLineNo + parserLineNo - 1, true
+}
+
+// reset is called when the file has been replaced underneath us - any open commands
+// are in the previous file so can't be re-read from this one
+func (p *logPosition) reset() {
+	fed := p.lineNo - p.baseLineNo // lines fed to the parser so far
+	p.replaced = append(p.replaced, replacedFile{lastLineNo: fed, baseLineNo: p.baseLineNo})
+	p.baseLineNo = 1 - fed
+	p.offset = 0
+	p.lineNo = 1
+	p.blockLineNo = 0
+	p.open = make(map[int64]openCommand)
+}
+
+// update sets the resume position in the state: the start of the oldest open command (or the
+// next line if none are open), plus the open commands and the current line so that on restart
+// commands already published can be dropped.
+func (p *logPosition) update(st *fileState) {
+	offset, lineNo := p.offset, p.lineNo
+	openLines := make([]int64, 0, len(p.open))
+	for _, c := range p.open {
+		if c.offset < offset {
+			offset, lineNo = c.offset, c.lineNo
+		}
+		openLines = append(openLines, c.lineNo)
+	}
+	sort.Slice(openLines, func(i, j int) bool { return openLines[i] < openLines[j] })
+	st.setOffset(offset, p.offset)
+	st.LineNo = lineNo
+	st.ReadLineNo = p.lineNo
+	st.OpenLines = openLines
+}
+
+// alreadyPublished is true for commands re-read after a restart which were published last time,
+// i.e. those started before the previous read position which were not open at the time.
+func (st *fileState) alreadyPublished(lineNo int64) bool {
+	if lineNo >= st.ReadLineNo {
+		return false
+	}
+	i := sort.Search(len(st.OpenLines), func(i int) bool { return st.OpenLines[i] >= lineNo })
+	return i == len(st.OpenLines) || st.OpenLines[i] != lineNo
+}
+
+func toInt64(buf string) (n int64) {
+	for _, v := range buf {
+		n = n*10 + int64(v-'0')
+	}
+	return
+}
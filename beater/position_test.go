@@ -0,0 +1,194 @@
+//go:build !integration
+// +build !integration
+
+package beater
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Two commands (pids 100 and 200) start in separate info blocks, then pid 100 has a track record
+// which repeats its command line (including the start time)
+var positionLines = []string{
+	"Perforce server info:",
+	"\t2018/09/02 10:00:00 pid 100 fred@fred_ws 10.0.0.1 [p4/2018.1/LINUX26X86_64/1660568] 'user-sync //...'",
+	"",
+	"Perforce server info:",
+	"\t2018/09/02 10:00:01 pid 200 bill@bill_ws 10.0.0.2 [p4/2018.1/LINUX26X86_64/1660568] 'user-info'",
+	"",
+	"Perforce server info:",
+	"\t2018/09/02 10:00:00 pid 100 fred@fred_ws 10.0.0.1 [p4/2018.1/LINUX26X86_64/1660568] 'user-sync //...'",
+	"--- lapse 2s",
+	"",
+}
+
+// offsetOf returns the offset of the line at lineNo (counting from 1) in positionLines
+func offsetOf(lineNo int) int64 {
+	var offset int64
+	for _, line := range positionLines[:lineNo-1] {
+		offset += int64(len(line)) + 1
+	}
+	return offset
+}
+
+func TestLogPositionUpdate(t *testing.T) {
+	type output struct{ pid, lineNo int64 }
+	end := int64(len(positionLines) + 1)
+	tests := []struct {
+		name       string
+		outputs    []output
+		wantLineNo int64
+		wantOpen   []int64
+	}{
+		{"none output", nil, 1, []int64{1, 4}},
+		{"oldest output", []output{{100, 1}}, 4, []int64{4}},
+		{"newest output", []output{{200, 4}}, 1, []int64{1}},
+		{"all output", []output{{200, 4}, {100, 1}}, end, []int64{}},
+		{"output before open command", []output{{200, 2}}, 1, []int64{1, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newLogPosition(0, 1)
+			for _, line := range positionLines {
+				p.addLine(line)
+			}
+			for _, o := range tt.outputs {
+				p.commandOutput(o.pid, o.lineNo)
+			}
+			var st fileState
+			p.update(&st)
+			wantOffset := offsetOf(int(tt.wantLineNo))
+			if tt.wantLineNo == end {
+				wantOffset = p.offset
+			}
+			if st.Offset != wantOffset || st.LineNo != tt.wantLineNo {
+				t.Errorf("resume at offset %d line %d, want offset %d line %d", st.Offset, st.LineNo, wantOffset, tt.wantLineNo)
+			}
+			if st.ReadLineNo != end {
+				t.Errorf("read line %d, want %d", st.ReadLineNo, end)
+			}
+			if !reflect.DeepEqual(st.OpenLines, tt.wantOpen) {
+				t.Errorf("open lines %v, want %v", st.OpenLines, tt.wantOpen)
+			}
+		})
+	}
+}
+
+func TestLogPositionResumed(t *testing.T) {
+	// Resuming from line 100 at offset 5000 - the parser numbers lines from 1
+	p := newLogPosition(5000, 100)
+	for _, line := range positionLines {
+		p.addLine(line)
+	}
+	if lineNo, current := p.absLineNo(4); lineNo != 103 || !current {
+		t.Errorf("absLineNo(4) = %d, %v, want 103, true", lineNo, current)
+	}
+	p.commandOutput(100, 100)
+	var st fileState
+	p.update(&st)
+	if st.Offset != 5000+offsetOf(4) || st.LineNo != 103 {
+		t.Errorf("resume at offset %d line %d, want offset %d line 103", st.Offset, st.LineNo, 5000+offsetOf(4))
+	}
+}
+
+func TestLogPositionNewCommandForPid(t *testing.T) {
+	// The parser outputs the previous command for a pid when a different one starts
+	p := newLogPosition(0, 1)
+	for _, line := range positionLines[:6] {
+		p.addLine(line)
+	}
+	p.addLine("Perforce server info:")
+	p.addLine("\t2018/09/02 10:00:03 pid 100 fred@fred_ws 10.0.0.1 [p4/2018.1/LINUX26X86_64/1660568] 'user-changes -m1'")
+	var st fileState
+	p.update(&st)
+	if want := []int64{4, 7}; !reflect.DeepEqual(st.OpenLines, want) {
+		t.Errorf("open lines %v, want %v", st.OpenLines, want)
+	}
+}
+
+func TestLogPositionAbsLineNo(t *testing.T) {
+	tests := []struct {
+		name         string
+		feeds        []int // lines fed to the parser, with the file replaced between each
+		parserLineNo int64
+		wantLineNo   int64
+		wantCurrent  bool
+	}{
+		{"not replaced", []int{5}, 3, 1002, true},
+		{"previous file", []int{5, 2}, 5, 1004, false},
+		{"replacement file", []int{5, 2}, 6, 1, true},
+		{"replacement file later", []int{5, 2}, 7, 2, true},
+		{"replaced twice first file", []int{5, 2, 1}, 3, 1002, false},
+		{"replaced twice first file end", []int{5, 2, 1}, 5, 1004, false},
+		{"replaced twice previous file start", []int{5, 2, 1}, 6, 1, false},
+		{"replaced twice previous file", []int{5, 2, 1}, 7, 2, false},
+		{"replaced twice", []int{5, 2, 1}, 8, 1, true},
+		{"replaced with empty file", []int{5, 0, 3}, 4, 1003, false},
+		{"after empty file", []int{5, 0, 3}, 6, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newLogPosition(5000, 1000)
+			for i, n := range tt.feeds {
+				if i > 0 {
+					p.reset()
+				}
+				for j := 0; j < n; j++ {
+					p.addLine("line")
+				}
+			}
+			lineNo, current := p.absLineNo(tt.parserLineNo)
+			if lineNo != tt.wantLineNo || current != tt.wantCurrent {
+				t.Errorf("absLineNo(%d) = %d, %v, want %d, %v", tt.parserLineNo, lineNo, current, tt.wantLineNo, tt.wantCurrent)
+			}
+		})
+	}
+}
+
+func TestLogPositionReplacedOften(t *testing.T) {
+	// Commands output from any earlier file keep their line number in that file
+	p := newLogPosition(5000, 1000)
+	var want []int64 // by parser line number - 1
+	for i := 0; i < 10; i++ {
+		if i > 0 {
+			p.reset()
+		}
+		for j := int64(0); j <= int64(i); j++ {
+			p.addLine("line")
+			if i == 0 {
+				want = append(want, 1000+j)
+			} else {
+				want = append(want, 1+j)
+			}
+		}
+	}
+	for i, w := range want {
+		parserLineNo := int64(i + 1)
+		lineNo, current := p.absLineNo(parserLineNo)
+		if lineNo != w || current != (i >= len(want)-10) {
+			t.Errorf("absLineNo(%d) = %d, %v, want %d, %v", parserLineNo, lineNo, current, w, i >= len(want)-10)
+		}
+	}
+}
+
+func TestAlreadyPublished(t *testing.T) {
+	st := fileState{ReadLineNo: 100, OpenLines: []int64{10, 50}}
+	tests := []struct {
+		lineNo int64
+		want   bool
+	}{
+		{5, true},
+		{10, false}, // open when the state was saved
+		{30, true},
+		{50, false},
+		{99, true},
+		{100, false}, // not read when the state was saved
+		{150, false},
+	}
+	for _, tt := range tests {
+		if got := st.alreadyPublished(tt.lineNo); got != tt.want {
+			t.Errorf("alreadyPublished(%d) = %v, want %v", tt.lineNo, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package beater
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	p4dlog "github.com/rcowham/go-libp4dlog"
+	"github.com/rcowham/p4dbeat/config"
+)
+
+// Length of the hex tokens which replace hashed values
+const tokenLength = 16
+
+// User attributes which identify the person, dropped unless users are kept
+var personalUserFields = []string{"p4.user_full_name", "p4.user_email", "p4.user_manager"}
+
+// pseudonymiser replaces personal data with tokens (or removes it) before events are published.
+// Tokens are an HMAC of the value keyed per field, so the same user always gives the same token
+// and events can still be joined, but they can't be reversed without the key.
+type pseudonymiser struct {
+	cfg      config.Privacy
+	key      []byte
+	patterns []*regexp.Regexp
+}
+
+// newPseudonymiser returns nil if all fields are kept
+func newPseudonymiser(cfg config.Privacy) (*pseudonymiser, error) {
+	if cfg.User == config.PrivacyKeep && cfg.Workspace == config.PrivacyKeep &&
+		cfg.IP == config.PrivacyKeep && cfg.Args.Mode == config.PrivacyKeep {
+		return nil, nil
+	}
+	p := &pseudonymiser{cfg: cfg, key: []byte(cfg.Key)}
+	if cfg.KeyFile != "" {
+		b, err := ioutil.ReadFile(cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read privacy key file: %v", err)
+		}
+		p.key = []byte(strings.TrimSpace(string(b)))
+	}
+	for _, re := range cfg.Args.Patterns {
+		r, err := regexp.Compile(re)
+		if err != nil {
+			return nil, err
+		}
+		p.patterns = append(p.patterns, r)
+	}
+	return p, nil
+}
+
+// token returns the token for the value of the field - fields have their own keys so the
+// same value in different fields gives different tokens
+func (p *pseudonymiser) token(field, value string) string {
+	mac := hmac.New(sha256.New, p.key)
+	fmt.Fprintf(mac, "%s\x00%s", field, value)
+	return hex.EncodeToString(mac.Sum(nil))[:tokenLength]
+}
+
+func (p *pseudonymiser) value(mode, field, value string) string {
+	switch mode {
+	case config.PrivacyHash:
+		if value != "" {
+			return p.token(field, value)
+		}
+	case config.PrivacyDrop:
+		return ""
+	}
+	return value
+}
+
+// scrubArgs replaces the patterns in the args and truncates them, at a space if possible
+// so the last arg isn't decoded as something else (e.g. @1234 as @12)
+func (p *pseudonymiser) scrubArgs(args string) string {
+	for _, re := range p.patterns {
+		args = re.ReplaceAllString(args, p.cfg.Args.Replacement)
+	}
+	if max := p.cfg.Args.MaxLength; max > 0 && len(args) > max {
+		if i := strings.LastIndex(args[:max+1], " "); i > 0 {
+			args = args[:i]
+		} else {
+			args = args[:max]
+		}
+	}
+	return args
+}
+
+// command pseudonymises the user, workspace and args of the command, so rollups, metrics
+// etc. from it only see the tokens. IPs are handled in the event as they are typed fields.
+func (p *pseudonymiser) command(command *p4dlog.Command) {
+	command.User = p.value(p.cfg.User, "user", command.User)
+	command.Workspace = p.value(p.cfg.Workspace, "workspace", command.Workspace)
+	if p.cfg.Args.Mode == config.PrivacyScrub {
+		command.Args = p.scrubArgs(command.Args)
+	} else {
+		command.Args = p.value(p.cfg.Args.Mode, "args", command.Args)
+	}
+}
+
+// event pseudonymises fields of a command event derived from the user, workspace, IPs and args
+func (p *pseudonymiser) event(event *beat.Event) {
+	if p.cfg.User == config.PrivacyDrop {
+		delete(event.Fields, "p4.user")
+	}
+	if p.cfg.Workspace == config.PrivacyDrop {
+		delete(event.Fields, "p4.workspace")
+	}
+	p.field(event, "p4.arg.user", p.cfg.User, "user")
+	p.field(event, "p4.arg.client", p.cfg.Workspace, "workspace")
+	if p.cfg.User != config.PrivacyKeep {
+		for _, k := range personalUserFields {
+			delete(event.Fields, k)
+		}
+	}
+	switch p.cfg.Args.Mode {
+	case config.PrivacyHash, config.PrivacyDrop:
+		for k := range event.Fields {
+			if strings.HasPrefix(k, "p4.arg.") {
+				delete(event.Fields, k)
+			}
+		}
+		if p.cfg.Args.Mode == config.PrivacyDrop {
+			delete(event.Fields, "p4.args")
+		}
+	}
+	p.ipField(event, "p4.ip")
+	p.ipField(event, "p4.proxy_ip")
+}
+
+// audit pseudonymises the user, workspace and IPs of an audit event
+func (p *pseudonymiser) audit(event *beat.Event) {
+	p.field(event, "p4.audit.user", p.cfg.User, "user")
+	p.field(event, "p4.audit.client", p.cfg.Workspace, "workspace")
+	p.ipField(event, "p4.audit.ip")
+	p.ipField(event, "p4.audit.proxy_ip")
+}
+
+// structured pseudonymises the user, client, host and args of a structured log record
+func (p *pseudonymiser) structured(event *beat.Event, prefix string) {
+	p.field(event, prefix+"user", p.cfg.User, "user")
+	p.field(event, prefix+"client", p.cfg.Workspace, "workspace")
+	p.ipField(event, prefix+"host")
+	p.argsField(event, prefix+"args")
+}
+
+// record pseudonymises the user, workspace, IP and args of a JSON input record
+func (p *pseudonymiser) record(event *beat.Event) {
+	p.field(event, "p4.user", p.cfg.User, "user")
+	p.field(event, "p4.workspace", p.cfg.Workspace, "workspace")
+	p.ipField(event, "p4.ip")
+	p.argsField(event, "p4.args")
+}
+
+func (p *pseudonymiser) field(event *beat.Event, key, mode, field string) {
+	v, ok := event.Fields[key].(string)
+	if !ok {
+		return
+	}
+	if v = p.value(mode, field, v); v == "" {
+		delete(event.Fields, key)
+	} else {
+		event.Fields[key] = v
+	}
+}
+
+// ipField replaces a hashed IP with <key>_token, as the token isn't a valid IP
+func (p *pseudonymiser) ipField(event *beat.Event, key string) {
+	v, ok := event.Fields[key].(string)
+	if !ok || p.cfg.IP == config.PrivacyKeep {
+		return
+	}
+	delete(event.Fields, key)
+	if p.cfg.IP == config.PrivacyHash {
+		event.Fields[key+"_token"] = p.token("ip", v)
+	}
+}
+
+func (p *pseudonymiser) argsField(event *beat.Event, key string) {
+	v, ok := event.Fields[key].(string)
+	if !ok {
+		return
+	}
+	if p.cfg.Args.Mode == config.PrivacyScrub {
+		v = p.scrubArgs(v)
+	} else {
+		v = p.value(p.cfg.Args.Mode, "args", v)
+	}
+	if v == "" {
+		delete(event.Fields, key)
+	} else {
+		event.Fields[key] = v
+	}
+}
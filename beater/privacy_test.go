@@ -0,0 +1,182 @@
+//go:build !integration
+// +build !integration
+
+package beater
+
+import (
+	"reflect"
+	"testing"
+
+	p4dlog "github.com/rcowham/go-libp4dlog"
+	"github.com/rcowham/p4dbeat/config"
+)
+
+func newTestPseudonymiser(t *testing.T, cfg config.Privacy) *pseudonymiser {
+	p, err := newPseudonymiser(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestPrivacyToken(t *testing.T) {
+	p := newTestPseudonymiser(t, config.Privacy{Key: "key1", User: config.PrivacyHash})
+	token := p.token("user", "fred")
+	if len(token) != tokenLength {
+		t.Errorf("token %q has length %d, want %d", token, len(token), tokenLength)
+	}
+	if p.token("user", "fred") != token {
+		t.Error("token for the same value changed")
+	}
+	if p.token("user", "bill") == token {
+		t.Error("different values have the same token")
+	}
+	if p.token("workspace", "fred") == token {
+		t.Error("different fields have the same token")
+	}
+	other := newTestPseudonymiser(t, config.Privacy{Key: "key2", User: config.PrivacyHash})
+	if other.token("user", "fred") == token {
+		t.Error("different keys give the same token")
+	}
+	again := newTestPseudonymiser(t, config.Privacy{Key: "key1", User: config.PrivacyHash})
+	if again.token("user", "fred") != token {
+		t.Error("token changed with the same key")
+	}
+}
+
+func TestPrivacyScrubArgs(t *testing.T) {
+	tests := []struct {
+		name      string
+		patterns  []string
+		maxLength int
+		args      string
+		want      string
+	}{
+		{"unchanged", []string{`secret`}, 0, "//depot/main/...", "//depot/main/..."},
+		{"replaced", []string{`//depot/secret/\S*`}, 0, "-n //depot/secret/a.c //depot/main/...", "-n <redacted> //depot/main/..."},
+		{"all patterns", []string{`fred`, `bill`}, 0, "-u fred -c bill_ws", "-u <redacted> -c <redacted>_ws"},
+		{"truncated at space", nil, 20, "//depot/main/... //depot/dev/...@1234", "//depot/main/..."},
+		{"truncated at limit", nil, 10, "//depot/main/...", "//depot/ma"},
+		{"short enough", nil, 16, "//depot/main/...", "//depot/main/..."},
+		{"replaced then truncated", []string{`//depot/secret/\S*`}, 15, "//depot/secret/a.c //depot/main/...", "<redacted>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPseudonymiser(t, config.Privacy{User: config.PrivacyKeep, Workspace: config.PrivacyKeep,
+				IP: config.PrivacyKeep, Args: config.PrivacyArgs{Mode: config.PrivacyScrub, Patterns: tt.patterns,
+					Replacement: "<redacted>", MaxLength: tt.maxLength}})
+			if got := p.scrubArgs(tt.args); got != tt.want {
+				t.Errorf("scrubArgs(%q) = %q, want %q", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+// publishAll publishes a command, an audit record, a structured log record and a JSON record
+func publishAll(t *testing.T, bt *P4dbeat) {
+	in := bt.newLogInput(config.Input{Path: "log"}, "log", "log")
+	bt.publishCommand(in, p4dlog.Command{Cmd: "user-changes", User: "fred", Workspace: "fred_ws", IP: "10.0.0.9/10.0.0.1",
+		Args: "-u fred -c fred_ws //depot/main/...", Pid: 1, LineNo: 1}, nil)
+	rec, err := parseAuditLine("2020/05/11 16:12:47 fred@fred_ws 10.0.0.9/10.0.0.1 sync //depot/main/a.c#3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bt.publishAudit(in, rec, nil)
+	in.parser = config.ParserStructuredCommands
+	bt.publishStructured(in, "0,1,1536228000,0,,1,1,fred,fred_ws,user-sync,10.0.0.1,p4,2018.1,//depot/main/...", 1, nil)
+	bt.publishEvent(`{"cmd": "user-submit", "user": "fred", "workspace": "fred_ws", "ip": "10.0.0.1", "args": "-c 1234"}`)
+	if n := len(bt.published()); n != 4 {
+		t.Fatalf("%d events published, want 4", n)
+	}
+}
+
+func TestPrivacyDrop(t *testing.T) {
+	bt := newTestBeat()
+	bt.privacy = newTestPseudonymiser(t, config.Privacy{User: config.PrivacyDrop, Workspace: config.PrivacyDrop,
+		IP: config.PrivacyDrop, Args: config.PrivacyArgs{Mode: config.PrivacyDrop}})
+	publishAll(t, bt)
+	dropped := [][]string{
+		{"p4.user", "p4.workspace", "p4.ip", "p4.proxy_ip", "p4.ip_token", "p4.args", "p4.arg.user", "p4.arg.client", "p4.arg.paths"},
+		{"p4.audit.user", "p4.audit.client", "p4.audit.ip", "p4.audit.proxy_ip", "p4.audit.ip_token"},
+		{"p4.structured.commands.user", "p4.structured.commands.client", "p4.structured.commands.host",
+			"p4.structured.commands.host_token", "p4.structured.commands.args"},
+		{"p4.user", "p4.workspace", "p4.ip", "p4.ip_token", "p4.args"},
+	}
+	for i, event := range bt.published() {
+		for _, k := range dropped[i] {
+			if v, ok := event.Fields[k]; ok {
+				t.Errorf("event %d has %s = %v", i, k, v)
+			}
+		}
+		if _, ok := event.Fields["type"]; !ok {
+			t.Errorf("event %d has no type", i)
+		}
+	}
+}
+
+func TestPrivacyHash(t *testing.T) {
+	bt := newTestBeat()
+	bt.privacy = newTestPseudonymiser(t, config.Privacy{Key: "key", User: config.PrivacyHash, Workspace: config.PrivacyHash,
+		IP: config.PrivacyHash, Args: config.PrivacyArgs{Mode: config.PrivacyKeep}})
+	publishAll(t, bt)
+	p := bt.privacy
+	user, ws, ip := p.token("user", "fred"), p.token("workspace", "fred_ws"), p.token("ip", "10.0.0.1")
+	want := []map[string]interface{}{
+		{"p4.user": user, "p4.workspace": ws, "p4.ip_token": ip, "p4.proxy_ip_token": p.token("ip", "10.0.0.9"),
+			"p4.arg.user": user, "p4.arg.client": ws},
+		{"p4.audit.user": user, "p4.audit.client": ws, "p4.audit.ip_token": ip},
+		{"p4.structured.commands.user": user, "p4.structured.commands.client": ws, "p4.structured.commands.host_token": ip},
+		{"p4.user": user, "p4.workspace": ws, "p4.ip_token": ip},
+	}
+	for i, event := range bt.published() {
+		for k, v := range want[i] {
+			if got := event.Fields[k]; got != v {
+				t.Errorf("event %d %s = %v, want %v", i, k, got, v)
+			}
+		}
+		for _, k := range []string{"p4.ip", "p4.proxy_ip", "p4.audit.ip", "p4.structured.commands.host"} {
+			if v, ok := event.Fields[k]; ok {
+				t.Errorf("event %d has %s = %v", i, k, v)
+			}
+		}
+	}
+}
+
+func TestPrivacyArgsFields(t *testing.T) {
+	// p4.arg.* are decoded from the args after they have been scrubbed, and are dropped if
+	// the args are hashed
+	tests := []struct {
+		mode  string
+		args  interface{}
+		paths interface{}
+	}{
+		{config.PrivacyScrub, "-m 10 <redacted> //depot/main/...", []string{"<redacted>", "//depot/main/..."}},
+		{config.PrivacyHash, nil, nil},
+		{config.PrivacyKeep, "-m 10 //depot/secret/a.c //depot/main/...", []string{"//depot/secret/a.c", "//depot/main/..."}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			bt := newTestBeat()
+			bt.privacy = newTestPseudonymiser(t, config.Privacy{Key: "key", User: config.PrivacyHash, Workspace: config.PrivacyKeep,
+				IP: config.PrivacyKeep, Args: config.PrivacyArgs{Mode: tt.mode, Patterns: []string{`//depot/secret/\S*`},
+					Replacement: "<redacted>"}})
+			in := bt.newLogInput(config.Input{Path: "log"}, "log", "log")
+			bt.publishCommand(in, p4dlog.Command{Cmd: "user-sync", User: "fred",
+				Args: "-m 10 //depot/secret/a.c //depot/main/...", Pid: 1, LineNo: 1}, nil)
+			event := bt.published()[0]
+			if tt.mode == config.PrivacyHash {
+				if got := event.Fields["p4.args"]; got != bt.privacy.token("args", "-m 10 //depot/secret/a.c //depot/main/...") {
+					t.Errorf("p4.args = %v, want token", got)
+				}
+			} else if got := event.Fields["p4.args"]; got != tt.args {
+				t.Errorf("p4.args = %v, want %v", got, tt.args)
+			}
+			if got, ok := event.Fields["p4.arg.paths"]; tt.paths == nil && ok || tt.paths != nil && !reflect.DeepEqual(got, tt.paths) {
+				t.Errorf("p4.arg.paths = %v, want %v", got, tt.paths)
+			}
+			if _, ok := event.Fields["p4.arg.max"]; ok == (tt.mode == config.PrivacyHash) {
+				t.Errorf("p4.arg.max published with args %s", tt.mode)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package beater
+
+import (
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	p4dlog "github.com/rcowham/go-libp4dlog"
+)
+
+// rollupKey identifies a group of commands aggregated in a rollup event
+type rollupKey struct {
+	start  time.Time // start of the period
+	fields string    // static fields of the input (see staticFieldsKey)
+	cmd    string
+	user   string
+	app    string
+}
+
+// rollupStats are the totals for a group of commands
+type rollupStats struct {
+	fields       common.MapStr // static fields of the input
+	count        int64
+	errors       int64
+	computeSec   float64
+	completedSec float64
+	uCPU         int64
+	sCPU         int64
+	diskIn       int64
+	diskOut      int64
+	rpcMsgsIn    int64
+	rpcMsgsOut   int64
+	rpcSizeIn    int64
+	rpcSizeOut   int64
+	readWaitMS   int64
+	readHeldMS   int64
+	writeWaitMS  int64
+	writeHeldMS  int64
+}
+
+// commandRollup aggregates commands by period (of the event timestamp) and (cmd, user, app),
+// so that long term trends can be kept without keeping every command.
+// Commands are added from each input's goroutine.
+type commandRollup struct {
+	mu     sync.Mutex
+	period time.Duration
+	groups map[rollupKey]*rollupStats
+}
+
+func newCommandRollup(period time.Duration) *commandRollup {
+	return &commandRollup{
+		period: period,
+		groups: make(map[rollupKey]*rollupStats),
+	}
+}
+
+// add adds the command to the group for its timestamp
+func (r *commandRollup) add(in *logInput, timestamp time.Time, command *p4dlog.Command) {
+	key := rollupKey{
+		start:  timestamp.Truncate(r.period),
+		fields: in.fieldsKey,
+		cmd:    command.Cmd,
+		user:   command.User,
+		app:    command.App,
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.groups[key]
+	if !ok {
+		s = &rollupStats{fields: in.fields}
+		r.groups[key] = s
+	}
+	s.count++
+	if command.CmdError {
+		s.errors++
+	}
+	s.computeSec += float64(command.ComputeLapse)
+	s.completedSec += float64(command.CompletedLapse)
+	s.uCPU += command.UCpu
+	s.sCPU += command.SCpu
+	s.diskIn += command.DiskIn
+	s.diskOut += command.DiskOut
+	s.rpcMsgsIn += command.RPCMsgsIn
+	s.rpcMsgsOut += command.RPCMsgsOut
+	s.rpcSizeIn += command.RPCSizeIn
+	s.rpcSizeOut += command.RPCSizeOut
+	for _, t := range command.Tables {
+		s.readWaitMS += t.TotalReadWait
+		s.readHeldMS += t.TotalReadHeld
+		s.writeWaitMS += t.TotalWriteWait
+		s.writeHeldMS += t.TotalWriteHeld
+	}
+}
+
+// take removes and returns the groups for periods which ended before the specified time,
+// or all groups if it is zero
+func (r *commandRollup) take(before time.Time) map[rollupKey]*rollupStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ended := make(map[rollupKey]*rollupStats)
+	for k, s := range r.groups {
+		if before.IsZero() || !k.start.Add(r.period).After(before) {
+			ended[k] = s
+			delete(r.groups, k)
+		}
+	}
+	return ended
+}
+
+// publishRollups publishes an event for each group of commands taken from the rollup.
+// Commands which arrive after their period has been published (e.g. long running ones)
+// are published in a further event for the same period.
+func (bt *P4dbeat) publishRollups(groups map[rollupKey]*rollupStats) {
+	for k, s := range groups {
+		event := beat.Event{
+			Timestamp: k.start,
+			Fields: common.MapStr{
+				"type":                                 bt.name,
+				"p4.cmd":                               k.cmd,
+				"p4.user":                              k.user,
+				"p4.app":                               k.app,
+				"p4.rollup.period_sec":                 bt.rollup.period.Seconds(),
+				"p4.rollup.count":                      s.count,
+				"p4.rollup.errors":                     s.errors,
+				"p4.rollup.compute_sec":                s.computeSec,
+				"p4.rollup.completed_sec":              s.completedSec,
+				"p4.rollup.cpu.user":                   s.uCPU,
+				"p4.rollup.cpu.system":                 s.sCPU,
+				"p4.rollup.disk.read_bytes":            s.diskIn * 512,
+				"p4.rollup.disk.write_bytes":           s.diskOut * 512,
+				"p4.rollup.rpc.msgs.in":                s.rpcMsgsIn,
+				"p4.rollup.rpc.msgs.out":               s.rpcMsgsOut,
+				"p4.rollup.rpc.size.in":                s.rpcSizeIn,
+				"p4.rollup.rpc.size.out":               s.rpcSizeOut,
+				"p4.rollup.locks.read.wait.total_sec":  float64(s.readWaitMS) / 1000.0,
+				"p4.rollup.locks.read.held.total_sec":  float64(s.readHeldMS) / 1000.0,
+				"p4.rollup.locks.write.wait.total_sec": float64(s.writeWaitMS) / 1000.0,
+				"p4.rollup.locks.write.held.total_sec": float64(s.writeHeldMS) / 1000.0,
+			},
+		}
+		for k, v := range s.fields {
+			event.Fields[k] = v
+		}
+		bt.publish(event)
+	}
+}
@@ -0,0 +1,75 @@
+//go:build !integration
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	p4dlog "github.com/rcowham/go-libp4dlog"
+	"github.com/rcowham/p4dbeat/config"
+)
+
+func TestAggregateStaticFields(t *testing.T) {
+	// Rollups, percentiles and contention events have all the static fields of the input, and
+	// inputs with the same static fields are aggregated together
+	bt := newTestBeat()
+	bt.rollup = newCommandRollup(time.Hour)
+	bt.latency = newLatencySummary(time.Minute, nil)
+	bt.contention = newContentionAnalyser(bt.config.Contention)
+	bt.contention.importing = true
+	instance := func(name string) *logInput {
+		return bt.newLogInput(config.Input{Path: name, Fields: common.MapStr{
+			"p4": common.MapStr{"serverid": "p4d1", "instance": name}}}, name, name)
+	}
+	a, a2, b := instance("a"), instance("a"), instance("b")
+	start := time.Date(2018, 9, 2, 10, 0, 0, 0, time.UTC)
+	command := func(pid int64, waitMS, heldMS int64) p4dlog.Command {
+		return p4dlog.Command{Cmd: "user-sync", User: "fred", Pid: pid, LineNo: pid,
+			StartTime: start, EndTime: start.Add(2 * time.Second), CompletedLapse: 2,
+			Tables: map[string]*p4dlog.Table{"rev": {TableName: "rev", TotalReadWait: waitMS, TotalWriteHeld: heldMS}}}
+	}
+	bt.publishCommand(a, command(1, 0, 1500), nil)
+	bt.publishCommand(a2, command(2, 1500, 0), nil)
+	bt.publishCommand(b, command(3, 0, 0), nil)
+	commands := len(bt.published())
+	bt.publishRollups(bt.rollup.take(time.Time{}))
+	bt.publishPercentiles(bt.latency.take(time.Time{}))
+	bt.publishContention(bt.contention.analyse(true))
+
+	counts := make(map[string]int64) // of aggregated commands by instance and kind
+	for _, e := range bt.published()[commands:] {
+		instance, _ := e.Fields["p4.instance"].(string)
+		if e.Fields["p4.serverid"] != "p4d1" || instance == "" {
+			t.Errorf("event without static fields: %v", e.Fields)
+		}
+		switch {
+		case e.Fields["p4.rollup.count"] != nil:
+			counts["rollup "+instance] += e.Fields["p4.rollup.count"].(int64)
+		case e.Fields["p4.latency.count"] != nil:
+			counts["latency "+instance] += e.Fields["p4.latency.count"].(int64)
+		case e.Fields["p4.contention.table"] != nil:
+			counts["contention "+instance]++
+		}
+	}
+	want := map[string]int64{"rollup a": 2, "rollup b": 1, "latency a": 2, "latency b": 1, "contention a": 1}
+	if len(counts) != len(want) {
+		t.Errorf("aggregated %v, want %v", counts, want)
+	}
+	for k, n := range want {
+		if counts[k] != n {
+			t.Errorf("%s: %d commands, want %d", k, counts[k], n)
+		}
+	}
+}
+
+func TestStaticFieldsKey(t *testing.T) {
+	k1 := staticFieldsKey(common.MapStr{"p4.serverid": "p4d1", "p4.instance": "a"})
+	k2 := staticFieldsKey(common.MapStr{"p4.instance": "a", "p4.serverid": "p4d1"})
+	k3 := staticFieldsKey(common.MapStr{"p4.instance": "b", "p4.serverid": "p4d1"})
+	if k1 != k2 || k1 == k3 || staticFieldsKey(nil) != "" {
+		t.Errorf("keys %q %q %q", k1, k2, k3)
+	}
+}
@@ -0,0 +1,168 @@
+package beater
+
+import (
+	"regexp"
+	"sync"
+	"time"
+
+	p4dlog "github.com/rcowham/go-libp4dlog"
+	"github.com/rcowham/p4dbeat/config"
+	"github.com/sirupsen/logrus"
+)
+
+// Commands slower than this are always kept, if a rule doesn't set max_completed
+const defaultSampleMaxCompleted = 100 * time.Millisecond
+
+// Largest factor the rates are raised by if there is no max_rate, so they can't overflow
+const maxSampleFactor = 1 << 20
+
+type sampleRule struct {
+	cmds         []*regexp.Regexp
+	maxCompleted time.Duration
+	rate         int
+	count        int64 // matching commands seen
+}
+
+// commandSampler decides which fast commands are published, keeping 1 in rate of them so
+// counts can be scaled back up by p4.sample_rate. The rates are multiplied by a factor which
+// doubles while the events published are over budget, and halves when well under it.
+type commandSampler struct {
+	mu            sync.Mutex
+	rules         []*sampleRule
+	lockThreshold int64 // ms
+	budget        int
+	maxRate       int
+	window        time.Duration
+	factor        int
+	windowStart   time.Time
+	windowEvents  int
+	log           *logrus.Logger
+}
+
+// newCommandSampler returns nil if there are no sampling rules
+func newCommandSampler(cfg config.Sampling, log *logrus.Logger) (*commandSampler, error) {
+	if len(cfg.Rules) == 0 {
+		return nil, nil
+	}
+	s := &commandSampler{
+		lockThreshold: cfg.LockThreshold.Milliseconds(),
+		budget:        cfg.MaxEventsPerSecond,
+		maxRate:       cfg.MaxRate,
+		window:        cfg.Window,
+		factor:        1,
+		log:           log,
+	}
+	if s.window <= 0 {
+		s.window = 10 * time.Second
+	}
+	for _, r := range cfg.Rules {
+		cmds, err := compilePatterns(r.Cmds)
+		if err != nil {
+			return nil, err
+		}
+		rule := &sampleRule{cmds: cmds, maxCompleted: r.MaxCompleted, rate: r.Rate}
+		if rule.maxCompleted <= 0 {
+			rule.maxCompleted = defaultSampleMaxCompleted
+		}
+		s.rules = append(s.rules, rule)
+	}
+	return s, nil
+}
+
+// sample returns whether the command should be published, and the rate it was sampled at
+func (s *commandSampler) sample(command *p4dlog.Command) (int, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.adjust(time.Now())
+	rule := s.rule(command)
+	if rule == nil {
+		s.windowEvents++
+		return 1, true
+	}
+	rate := rule.rate * s.factor
+	if s.maxRate > 0 && rate > s.maxRate {
+		rate = s.maxRate
+	}
+	if rate < rule.rate {
+		rate = rule.rate
+	}
+	rule.count++
+	if (rule.count-1)%int64(rate) != 0 {
+		return rate, false
+	}
+	s.windowEvents++
+	return rate, true
+}
+
+// rule returns the rule the command is sampled by, or nil if it must be kept
+func (s *commandSampler) rule(command *p4dlog.Command) *sampleRule {
+	if command.CmdError || s.lockHeavy(command) {
+		return nil
+	}
+	completed := time.Duration(float64(command.CompletedLapse) * float64(time.Second))
+	for _, r := range s.rules {
+		if completed < r.maxCompleted && matchAnyPattern(r.cmds, command.Cmd) {
+			return r
+		}
+	}
+	return nil
+}
+
+// lockHeavy returns true if the command waited for or held any table lock for the threshold
+func (s *commandSampler) lockHeavy(command *p4dlog.Command) bool {
+	if s.lockThreshold <= 0 {
+		return false
+	}
+	for _, t := range command.Tables {
+		for _, ms := range []int64{t.TotalReadWait, t.TotalWriteWait, t.TotalReadHeld, t.TotalWriteHeld} {
+			if ms >= s.lockThreshold {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// adjust changes the factor at the end of each window, if there is a budget. The budget is
+// of events actually published, so imports (which run faster than real time) are sampled harder.
+func (s *commandSampler) adjust(t time.Time) {
+	if s.windowStart.IsZero() {
+		s.windowStart = t
+		return
+	}
+	elapsed := t.Sub(s.windowStart)
+	if elapsed < s.window {
+		return
+	}
+	eps := float64(s.windowEvents) / elapsed.Seconds()
+	if s.budget > 0 {
+		factor := s.factor
+		if eps > float64(s.budget) && s.canRaise() {
+			factor = s.factor * 2
+		} else if eps < float64(s.budget)/2 && s.factor > 1 {
+			factor = s.factor / 2
+		}
+		if factor != s.factor {
+			s.log.Debugf("Sampling %.1f events/sec against a budget of %d - rates now x%d", eps, s.budget, factor)
+			s.factor = factor
+		}
+	}
+	s.windowStart, s.windowEvents = t, 0
+}
+
+// canRaise returns whether doubling the factor would raise the rate of any rule, which it
+// doesn't once they are all capped at maxRate (0 for no limit)
+func (s *commandSampler) canRaise() bool {
+	if s.factor >= maxSampleFactor {
+		return false
+	}
+	if s.maxRate <= 0 {
+		return true
+	}
+	for _, r := range s.rules {
+		if r.rate*s.factor < s.maxRate {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+//go:build !integration
+// +build !integration
+
+package beater
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+
+	p4dlog "github.com/rcowham/go-libp4dlog"
+	"github.com/rcowham/p4dbeat/config"
+	"github.com/sirupsen/logrus"
+)
+
+func newTestSampler(t *testing.T, maxRate int, rates ...int) *commandSampler {
+	log := logrus.New()
+	log.Out = ioutil.Discard
+	cfg := config.Sampling{MaxEventsPerSecond: 10, MaxRate: maxRate, Window: 10 * time.Second}
+	for _, r := range rates {
+		cfg.Rules = append(cfg.Rules, config.SampleRule{Cmds: []string{"user-fstat"}, Rate: r})
+	}
+	s, err := newCommandSampler(cfg, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestSampleRate(t *testing.T) {
+	s := newTestSampler(t, 1000, 10)
+	fstat := &p4dlog.Command{Cmd: "user-fstat", CompletedLapse: 0.01}
+	kept := 0
+	for i := 0; i < 100; i++ {
+		rate, keep := s.sample(fstat)
+		if rate != 10 {
+			t.Fatalf("rate %d, want 10", rate)
+		}
+		if keep {
+			kept++
+		}
+	}
+	if kept != 10 {
+		t.Errorf("%d of 100 kept, want 10", kept)
+	}
+	for _, c := range []*p4dlog.Command{
+		{Cmd: "user-fstat", CompletedLapse: 0.2},
+		{Cmd: "user-fstat", CompletedLapse: 0.01, CmdError: true},
+		{Cmd: "user-sync", CompletedLapse: 0.01},
+	} {
+		if rate, keep := s.sample(c); rate != 1 || !keep {
+			t.Errorf("%+v sampled at %d, %v, want always kept", c, rate, keep)
+		}
+	}
+}
+
+func TestSampleAdjust(t *testing.T) {
+	// Each window is over or under the budget of 10 events/sec, and the factor doubles or
+	// halves while it changes the rate
+	const over, under = 200, 10
+	tests := []struct {
+		name        string
+		maxRate     int
+		rates       []int
+		windows     []int // events published in each window of 10s
+		wantFactors []int // after each window
+	}{
+		{"raised to max rate", 1000, []int{10}, []int{over, over, over, over, over, over, over, over},
+			[]int{2, 4, 8, 16, 32, 64, 128, 128}},
+		{"lowered", 1000, []int{10}, []int{over, over, over, under, under, under},
+			[]int{2, 4, 8, 4, 2, 1}},
+		{"within budget", 1000, []int{10}, []int{80, over, 80, 80}, []int{1, 2, 2, 2}},
+		{"max rate below rule rate", 5, []int{10}, []int{over, over}, []int{1, 1}},
+		{"until all rules capped", 100, []int{1, 10}, []int{over, over, over, over, over, over, over, over},
+			[]int{2, 4, 8, 16, 32, 64, 128, 128}},
+		{"no max rate", 0, []int{10}, []int{over, over, over, over, over, over, over, over, over, over, over},
+			[]int{2, 4, 8, 16, 32, 64, 128, 256, 512, 1024, 2048}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestSampler(t, tt.maxRate, tt.rates...)
+			now := time.Unix(1536228000, 0)
+			s.adjust(now)
+			for i, n := range tt.windows {
+				s.windowEvents = n
+				now = now.Add(s.window)
+				s.adjust(now)
+				if s.factor != tt.wantFactors[i] {
+					t.Fatalf("factor %d after window %d, want %d", s.factor, i+1, tt.wantFactors[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSampleMaxRate(t *testing.T) {
+	fstat := &p4dlog.Command{Cmd: "user-fstat", CompletedLapse: 0.01}
+	tests := []struct {
+		maxRate, factor, want int
+	}{
+		{1000, 1, 10},
+		{1000, 64, 640},
+		{1000, 128, 1000},
+		{0, 1024, 10240},
+		{5, 1, 10}, // never below the rule rate
+	}
+	for _, tt := range tests {
+		s := newTestSampler(t, tt.maxRate, 10)
+		s.factor = tt.factor
+		if rate, _ := s.sample(fstat); rate != tt.want {
+			t.Errorf("max rate %d factor %d: rate %d, want %d", tt.maxRate, tt.factor, rate, tt.want)
+		}
+	}
+}
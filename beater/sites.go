@@ -0,0 +1,158 @@
+package beater
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/oschwald/geoip2-golang"
+	"github.com/rcowham/p4dbeat/config"
+)
+
+type siteSubnet struct {
+	net     *net.IPNet
+	site    string
+	region  string
+	network string
+}
+
+// ipEnricher adds the site of client and proxy IPs from the configured subnets, and their
+// location from a MaxMind database
+type ipEnricher struct {
+	subnets []siteSubnet // most specific first
+	geo     *geoip2.Reader
+	city    bool // database has cities, not just countries
+}
+
+// newIPEnricher returns nil if neither sites nor GeoIP are configured
+func newIPEnricher(sites config.Sites, geo config.GeoIP) (*ipEnricher, error) {
+	subnets := sites.Subnets
+	if sites.File != "" {
+		fileSubnets, err := loadSubnets(sites.File)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to load sites from '%s': %v", sites.File, err)
+		}
+		subnets = append(subnets, fileSubnets...)
+	}
+	if len(subnets) == 0 && geo.Database == "" {
+		return nil, nil
+	}
+	e := &ipEnricher{}
+	for _, s := range subnets {
+		_, n, err := net.ParseCIDR(strings.TrimSpace(s.CIDR))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid subnet '%s': %v", s.CIDR, err)
+		}
+		e.subnets = append(e.subnets, siteSubnet{net: n, site: s.Site, region: s.Region, network: s.Network})
+	}
+	sort.SliceStable(e.subnets, func(i, j int) bool {
+		a, _ := e.subnets[i].net.Mask.Size()
+		b, _ := e.subnets[j].net.Mask.Size()
+		return a > b
+	})
+	if geo.Database != "" {
+		r, err := geoip2.Open(geo.Database)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to open GeoIP database '%s': %v", geo.Database, err)
+		}
+		e.geo = r
+		e.city = strings.Contains(r.Metadata().DatabaseType, "City")
+	}
+	return e, nil
+}
+
+// loadSubnets reads subnets from a CSV file (cidr,site,region,network - header and
+// comment lines optional) or a YAML file with a subnets list
+func loadSubnets(path string) ([]config.Subnet, error) {
+	if strings.ToLower(filepath.Ext(path)) != ".csv" {
+		cfg, err := common.LoadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var s config.Sites
+		if err := cfg.Unpack(&s); err != nil {
+			return nil, err
+		}
+		return s.Subnets, nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r := csv.NewReader(f)
+	r.Comment = '#'
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	subnets := make([]config.Subnet, 0, len(records))
+	for i, rec := range records {
+		if i == 0 && strings.EqualFold(rec[0], "cidr") {
+			continue
+		}
+		for len(rec) < 4 {
+			rec = append(rec, "")
+		}
+		subnets = append(subnets, config.Subnet{CIDR: rec[0], Site: rec[1], Region: rec[2], Network: rec[3]})
+	}
+	return subnets, nil
+}
+
+// enrich adds the fields for the IP, prefixed by p4.<prefix>, e.g. p4.site, p4.proxy_site
+func (e *ipEnricher) enrich(event *beat.Event, prefix, ip string) {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return
+	}
+	for _, s := range e.subnets {
+		if !s.net.Contains(addr) {
+			continue
+		}
+		setIfNotEmpty(event, "p4."+prefix+"site", s.site)
+		setIfNotEmpty(event, "p4."+prefix+"region", s.region)
+		setIfNotEmpty(event, "p4."+prefix+"network", s.network)
+		break
+	}
+	if e.geo != nil {
+		e.enrichGeo(event, "p4."+prefix+"geo.", addr)
+	}
+}
+
+func (e *ipEnricher) enrichGeo(event *beat.Event, prefix string, addr net.IP) {
+	if !e.city {
+		c, err := e.geo.Country(addr)
+		if err != nil {
+			return
+		}
+		setIfNotEmpty(event, prefix+"country_iso_code", c.Country.IsoCode)
+		setIfNotEmpty(event, prefix+"country_name", c.Country.Names["en"])
+		setIfNotEmpty(event, prefix+"continent_name", c.Continent.Names["en"])
+		return
+	}
+	c, err := e.geo.City(addr)
+	if err != nil {
+		return
+	}
+	setIfNotEmpty(event, prefix+"country_iso_code", c.Country.IsoCode)
+	setIfNotEmpty(event, prefix+"country_name", c.Country.Names["en"])
+	setIfNotEmpty(event, prefix+"continent_name", c.Continent.Names["en"])
+	setIfNotEmpty(event, prefix+"city_name", c.City.Names["en"])
+	if len(c.Subdivisions) > 0 {
+		setIfNotEmpty(event, prefix+"region_name", c.Subdivisions[0].Names["en"])
+	}
+	if c.Location.Latitude != 0 || c.Location.Longitude != 0 {
+		event.Fields[prefix+"location"] = common.MapStr{
+			"lat": c.Location.Latitude,
+			"lon": c.Location.Longitude,
+		}
+	}
+}
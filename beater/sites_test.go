@@ -0,0 +1,91 @@
+//go:build !integration
+// +build !integration
+
+package beater
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/rcowham/p4dbeat/config"
+)
+
+func TestIPEnricherSites(t *testing.T) {
+	e, err := newIPEnricher(config.Sites{Subnets: []config.Subnet{
+		{CIDR: "10.0.0.0/8", Site: "corp", Region: "emea"},
+		{CIDR: "10.1.0.0/16", Site: "london", Network: "office"}, // more specific, listed later
+		{CIDR: "fd00::/8", Site: "lab"},
+	}}, config.GeoIP{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		ip   string
+		want common.MapStr
+	}{
+		{"10.1.2.3", common.MapStr{"p4.site": "london", "p4.network": "office"}},
+		{"10.2.2.3", common.MapStr{"p4.site": "corp", "p4.region": "emea"}},
+		{"fd00::1", common.MapStr{"p4.site": "lab"}},
+		{"192.168.1.1", common.MapStr{}},
+		{"unknown", common.MapStr{}},
+	}
+	for _, tt := range tests {
+		event := beat.Event{Fields: common.MapStr{}}
+		e.enrich(&event, "", tt.ip)
+		if event.Fields.String() != tt.want.String() {
+			t.Errorf("%s: %v, want %v", tt.ip, event.Fields, tt.want)
+		}
+	}
+	event := beat.Event{Fields: common.MapStr{}}
+	e.enrich(&event, "proxy_", "10.1.2.3")
+	if event.Fields["p4.proxy_site"] != "london" {
+		t.Errorf("proxy fields %v", event.Fields)
+	}
+}
+
+func TestIPEnricherNone(t *testing.T) {
+	e, err := newIPEnricher(config.Sites{}, config.GeoIP{})
+	if e != nil || err != nil {
+		t.Errorf("enricher %v, %v without sites or GeoIP", e, err)
+	}
+	if _, err := newIPEnricher(config.Sites{Subnets: []config.Subnet{{CIDR: "10.0.0.0"}}}, config.GeoIP{}); err == nil {
+		t.Error("no error for invalid subnet")
+	}
+}
+
+func TestLoadSubnets(t *testing.T) {
+	tests := []struct {
+		name, content string
+	}{
+		{"sites.csv", "cidr,site,region,network\n# offices\n10.1.0.0/16, london, emea, office\n10.2.0.0/16,paris\n"},
+		{"sites.yml", "subnets:\n  - cidr: 10.1.0.0/16\n    site: london\n    region: emea\n    network: office\n" +
+			"  - cidr: 10.2.0.0/16\n    site: paris\n"},
+	}
+	want := []config.Subnet{
+		{CIDR: "10.1.0.0/16", Site: "london", Region: "emea", Network: "office"},
+		{CIDR: "10.2.0.0/16", Site: "paris"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.name)
+			if err := ioutil.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatal(err)
+			}
+			got, err := loadSubnets(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != len(want) {
+				t.Fatalf("subnets %+v, want %+v", got, want)
+			}
+			for i := range want {
+				if got[i] != want[i] {
+					t.Errorf("subnet %d %+v, want %+v", i, got[i], want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package beater
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common/acker"
+	"github.com/elastic/beats/v7/libbeat/common/file"
+	"github.com/elastic/beats/v7/libbeat/statestore"
+)
+
+// Number of bytes at the head of a log file which are hashed to give its fingerprint
+const fingerprintSize = 1024
+
+// fileState is what we persist in the state registry for a log file.
+// As well as the offset we record the identity of the file (device/inode) and a
+// hash of its first bytes, so that on startup we can tell whether the file has been
+// rotated while the beat was not running.
+// The offset is the start of the oldest command still open in the parser (see logPosition).
+type fileState struct {
+	Source          string       `struct:"source"`
+	Offset          int64        `struct:"offset"`
+	LineNo          int64        `struct:"line_no"`      // line number at offset
+	ReadLineNo      int64        `struct:"read_line_no"` // line number read up to
+	OpenLines       []int64      `struct:"open_lines"`   // start lines of open commands (sorted)
+	FileStateOS     file.StateOS `struct:"FileStateOS"`
+	Fingerprint     string       `struct:"fingerprint"`
+	FingerprintSize int64        `struct:"fingerprint_size"`
+}
+
+// newFileState returns the state for the start of the specified file
+func newFileState(path string) (fileState, error) {
+	st := fileState{Source: path}
+	err := st.updateIdentity()
+	return st, err
+}
+
+// updateIdentity re-reads the device/inode and fingerprint of the file at st.Source
+func (st *fileState) updateIdentity() error {
+	info, err := os.Stat(st.Source)
+	if err != nil {
+		return err
+	}
+	st.FileStateOS = file.GetOSState(info)
+	st.Fingerprint, st.FingerprintSize, err = fingerprint(st.Source, fingerprintSize)
+	return err
+}
+
+// setOffset records a new offset. The fingerprint is refreshed while the file
+// is still smaller than fingerprintSize - readOffset is how far we have read.
+func (st *fileState) setOffset(offset, readOffset int64) {
+	if st.FingerprintSize < fingerprintSize && readOffset > st.FingerprintSize {
+		st.updateIdentity()
+	}
+	st.Offset = offset
+}
+
+// hasIdentity is false for state saved by versions which only stored the offset
+func (st *fileState) hasIdentity() bool {
+	return st.Fingerprint != ""
+}
+
+// isSameFile checks whether the file at path is the one recorded in the state:
+// device/inode must match, and the head of the file must still hash to the same
+// fingerprint (protects against inode reuse)
+func (st *fileState) isSameFile(path string, info os.FileInfo) bool {
+	if !st.FileStateOS.IsSame(file.GetOSState(info)) {
+		return false
+	}
+	if info.Size() < st.FingerprintSize {
+		return false
+	}
+	fp, _, err := fingerprint(path, st.FingerprintSize)
+	return err == nil && fp == st.Fingerprint
+}
+
+// fingerprint returns a hash of the first size bytes of the file, and how many bytes were hashed
+func fingerprint(path string, size int64) (string, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	n, err := io.CopyN(h, f, size)
+	if err != nil && err != io.EOF {
+		return "", 0, err
+	}
+	return hex.EncodeToString(h.Sum(nil)), n, nil
+}
+
+// loadState reads the state for the specified key - ok is false if none has been saved
+func loadState(store *statestore.Store, key string) (st fileState, ok bool, err error) {
+	if ok, err = store.Has(key); err != nil || !ok {
+		return st, false, err
+	}
+	if err = store.Get(key, &st); err != nil {
+		return st, false, err
+	}
+	return st, true, nil
+}
+
+// resumePosition decides where to start reading given the state saved last time:
+//   - same file as before: resume at the saved offset
+//   - file has been rotated: finish the rotated file (found by matching rotatedPattern)
+//     from the saved offset, then read the current file from the start
+//   - rotated file can't be found: read the current file from the start
+//
+// If rotated is empty only the current file needs to be read. If ok is false
+// the saved state doesn't apply and reading starts from the beginning.
+func (bt *P4dbeat) resumePosition(path, rotatedPattern string, st fileState) (rotated string, ok bool) {
+	info, err := os.Stat(path)
+	if !st.hasIdentity() {
+		// Old style state with only an offset - best we can do is check the size
+		if err == nil && info.Size() >= st.Offset {
+			return "", true
+		}
+		bt.log.Warnf("Log '%s' is smaller than saved offset %d, starting from the beginning", path, st.Offset)
+		return "", false
+	}
+	if err == nil && st.isSameFile(path, info) {
+		if info.Size() >= st.Offset {
+			return "", true
+		}
+		bt.log.Warnf("Log '%s' has been truncated, starting from the beginning", path)
+		return "", false
+	}
+	if rotatedPattern == "" {
+		rotatedPattern = path + ".*"
+	}
+	candidates, err := filepath.Glob(rotatedPattern)
+	if err != nil {
+		bt.log.Errorf("Invalid rotated log pattern '%s': %v", rotatedPattern, err)
+	}
+	for _, c := range candidates {
+		if c == path {
+			continue
+		}
+		if info, err := os.Stat(c); err == nil && st.isSameFile(c, info) {
+			bt.log.Infof("Log '%s' has been rotated to '%s', finishing it from offset %d", path, c, st.Offset)
+			return c, true
+		}
+	}
+	bt.log.Warnf("Log '%s' has been rotated but previous file (%s) not found, starting new file from the beginning",
+		path, st.FileStateOS)
+	return "", false
+}
+
+// offsetUpdate is attached to each published event (as Private) so that the
+// offset is only stored once the output has acknowledged the event
+type offsetUpdate struct {
+	key   string
+	state fileState
+}
+
+// ackOffsets returns an ACK handler which stores the latest acknowledged state for each input.
+// Events are acknowledged in order, so everything before that state has been delivered too.
+func (bt *P4dbeat) ackOffsets(store *statestore.Store) beat.ACKer {
+	return acker.EventPrivateReporter(func(_ int, data []interface{}) {
+		latest := make(map[string]fileState)
+		for _, d := range data {
+			if u, ok := d.(offsetUpdate); ok {
+				latest[u.key] = u.state
+			}
+		}
+		for key, st := range latest {
+			if err := store.Set(key, st); err != nil {
+				bt.log.Errorf("Failed to store offset for '%s': %v", key, err)
+			}
+		}
+	})
+}
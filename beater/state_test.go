@@ -0,0 +1,266 @@
+//go:build !integration
+// +build !integration
+
+package beater
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/statestore"
+	"github.com/elastic/beats/v7/libbeat/statestore/storetest"
+	p4dlog "github.com/rcowham/go-libp4dlog"
+	"github.com/rcowham/p4dbeat/config"
+	"github.com/sirupsen/logrus"
+)
+
+// testClient collects the events published
+type testClient struct {
+	events []beat.Event
+}
+
+func (c *testClient) Publish(event beat.Event) {
+	c.events = append(c.events, event)
+}
+
+func (c *testClient) PublishAll(events []beat.Event) {
+	c.events = append(c.events, events...)
+}
+
+func (c *testClient) Close() error {
+	return nil
+}
+
+// newTestBeat returns a beat with the default config, publishing to a testClient
+func newTestBeat() *P4dbeat {
+	log := logrus.New()
+	log.Out = ioutil.Discard
+	return &P4dbeat{
+		name:   "p4dbeat",
+		config: config.DefaultConfig,
+		loc:    time.UTC,
+		client: &testClient{},
+		log:    log,
+	}
+}
+
+// published returns the events published by a beat from newTestBeat
+func (bt *P4dbeat) published() []beat.Event {
+	return bt.client.(*testClient).events
+}
+
+func newTestStore(t *testing.T) *statestore.Store {
+	reg := statestore.NewRegistry(storetest.NewMemoryStoreBackend())
+	store, err := reg.Get("p4dbeat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		store.Close()
+		reg.Close()
+	})
+	return store
+}
+
+// storedOffsets returns the offset stored for each key, or -1 if none has been stored
+func storedOffsets(t *testing.T, store *statestore.Store, keys ...string) map[string]int64 {
+	offsets := make(map[string]int64)
+	for _, key := range keys {
+		st, ok, err := loadState(store, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		offsets[key] = -1
+		if ok {
+			offsets[key] = st.Offset
+		}
+	}
+	return offsets
+}
+
+func TestAckOffsets(t *testing.T) {
+	update := func(key string, offset int64) offsetUpdate {
+		return offsetUpdate{key: key, state: fileState{Source: key, Offset: offset}}
+	}
+	// Events in the order published, from two inputs. Alerts etc. have no offset, and events
+	// dropped by processors are acknowledged with the published events before them.
+	events := []struct {
+		private   interface{}
+		published bool
+	}{
+		{update("a", 100), true},
+		{nil, true},
+		{update("b", 50), true},
+		{update("a", 150), false},
+		{update("a", 200), true},
+		{update("b", 80), true},
+	}
+	tests := []struct {
+		name string
+		acks []int // number of events acknowledged by each ACK from the output
+		want map[string]int64
+	}{
+		{"none acknowledged", nil, map[string]int64{"a": -1, "b": -1}},
+		{"first", []int{1}, map[string]int64{"a": 100, "b": -1}},
+		{"up to event without offset", []int{2}, map[string]int64{"a": 100, "b": -1}},
+		{"up to dropped event", []int{3}, map[string]int64{"a": 150, "b": 50}},
+		{"separate acks", []int{1, 1, 1}, map[string]int64{"a": 150, "b": 50}},
+		{"all but last", []int{4}, map[string]int64{"a": 200, "b": 50}},
+		{"all", []int{2, 3}, map[string]int64{"a": 200, "b": 80}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newTestStore(t)
+			acker := newTestBeat().ackOffsets(store)
+			for _, e := range events {
+				acker.AddEvent(beat.Event{Private: e.private}, e.published)
+			}
+			if got := storedOffsets(t, store, "a", "b"); got["a"] != -1 || got["b"] != -1 {
+				t.Fatalf("offsets stored before any ACK: %v", got)
+			}
+			for _, n := range tt.acks {
+				acker.ACKEvents(n)
+			}
+			got := storedOffsets(t, store, "a", "b")
+			for key, want := range tt.want {
+				if got[key] != want {
+					t.Errorf("offset for %s = %d, want %d", key, got[key], want)
+				}
+			}
+		})
+	}
+}
+
+func TestPublishedOffsets(t *testing.T) {
+	// Each event from a log carries the offset update, which is stored when it is acknowledged
+	bt := newTestBeat()
+	in := bt.newLogInput(config.Input{Path: "log"}, "log", "log")
+	u := offsetUpdate{key: "log", state: fileState{Source: "log", Offset: 100}}
+	bt.publishCommand(in, p4dlog.Command{Cmd: "user-sync", Pid: 1, LineNo: 1}, u)
+	in.parser = config.ParserStructuredCommands
+	u.state.Offset = 200
+	bt.publishStructured(in, "0,1,1536228000", 2, u)
+	events := bt.published()
+	if len(events) != 2 {
+		t.Fatalf("%d events published, want 2", len(events))
+	}
+	for i, want := range []int64{100, 200} {
+		if got, ok := events[i].Private.(offsetUpdate); !ok || got.key != "log" || got.state.Offset != want {
+			t.Errorf("event %d private %#v, want offset %d", i, events[i].Private, want)
+		}
+	}
+}
+
+func TestResumePosition(t *testing.T) {
+	head := strings.Repeat("Perforce server info:\n", 100) // over fingerprintSize
+	short := "Perforce server info:\n"                     // under fingerprintSize
+	write := func(t *testing.T, path, data string) {
+		if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	appendTo := func(t *testing.T, path, data string) {
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		if _, err := f.WriteString(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rename := func(t *testing.T, from, to string) {
+		if err := os.MkdirAll(filepath.Dir(to), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Rename(from, to); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		name           string
+		initial        string
+		offset         int64
+		change         func(t *testing.T, path string) // while the beat is stopped
+		rotatedPattern string
+		wantRotated    string // relative to the directory of the log
+		wantOK         bool
+	}{
+		{"same file", head, 500, func(t *testing.T, path string) {}, "", "", true},
+		{"same file appended", head, 500, func(t *testing.T, path string) { appendTo(t, path, "more\n") }, "", "", true},
+		{"rotated", head, 500, func(t *testing.T, path string) {
+			rename(t, path, path+".1")
+			write(t, path, "new log\n")
+		}, "", "log.1", true},
+		{"rotated to pattern", head, 500, func(t *testing.T, path string) {
+			rename(t, path, filepath.Join(filepath.Dir(path), "old", "log-2018"))
+			write(t, path, "new log\n")
+		}, "old/log-*", "old/log-2018", true},
+		{"rotated elsewhere", head, 500, func(t *testing.T, path string) {
+			rename(t, path, filepath.Join(filepath.Dir(path), "old", "log-2018"))
+			write(t, path, "new log\n")
+		}, "", "", false},
+		{"rotated and deleted", head, 500, func(t *testing.T, path string) {
+			os.Remove(path)
+			write(t, path, "new log\n")
+		}, "", "", false},
+		{"truncated", head, 500, func(t *testing.T, path string) { os.Truncate(path, 100) }, "", "", false},
+		{"replaced in place", head, 500, func(t *testing.T, path string) {
+			write(t, path, strings.Replace(head, "info", "INFO", 1))
+		}, "", "", false},
+		{"short same file", short, 10, func(t *testing.T, path string) {}, "", "", true},
+		{"short file grown", short, 10, func(t *testing.T, path string) { appendTo(t, path, head) }, "", "", true},
+		{"short file replaced in place", short, 10, func(t *testing.T, path string) {
+			write(t, path, "Perforce server error:\n")
+		}, "", "", false},
+		{"short file rotated", short, 10, func(t *testing.T, path string) {
+			rename(t, path, path+".1")
+			write(t, path, short)
+		}, "", "log.1", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, "log")
+			write(t, path, tt.initial)
+			st, err := newFileState(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			st.Offset = tt.offset
+			tt.change(t, path)
+			pattern := tt.rotatedPattern
+			if pattern != "" {
+				pattern = filepath.Join(dir, pattern)
+			}
+			rotated, ok := newTestBeat().resumePosition(path, pattern, st)
+			wantRotated := ""
+			if tt.wantRotated != "" {
+				wantRotated = filepath.Join(dir, tt.wantRotated)
+			}
+			if rotated != wantRotated || ok != tt.wantOK {
+				t.Errorf("resumePosition = %q, %v, want %q, %v", rotated, ok, wantRotated, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestResumePositionOffsetOnly(t *testing.T) {
+	// State saved before file identities were stored only has an offset
+	path := filepath.Join(t.TempDir(), "log")
+	if err := ioutil.WriteFile(path, []byte("Perforce server info:\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	bt := newTestBeat()
+	if _, ok := bt.resumePosition(path, "", fileState{Offset: 10}); !ok {
+		t.Error("offset within the file not resumed")
+	}
+	if _, ok := bt.resumePosition(path, "", fileState{Offset: 100}); ok {
+		t.Error("offset past the end of the file resumed")
+	}
+}
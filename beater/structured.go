@@ -0,0 +1,278 @@
+package beater
+
+import (
+	"crypto/sha1"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/rcowham/p4dbeat/config"
+)
+
+// p4d structured logs (configured via serverlog.file.N) are CSV files with one record per line.
+// Every record starts with the event type and the record version, which determine the
+// rest of the columns. Columns are added over releases, so each column records the record
+// version it first appeared in. The event types and columns are as per the "Structured log
+// file fields" appendix of the Helix Core Server Administrator Guide. Columns not known for
+// the record version are still published (see parseStructured).
+
+type columnType int
+
+const (
+	colString columnType = iota
+	colInt
+	colFloat
+	colUnixTime
+)
+
+type column struct {
+	name  string
+	typ   columnType
+	since int // record version in which the column was added
+}
+
+// Columns common to all records after event type and record version
+var structuredHeader = []column{
+	{"timestamp", colUnixTime, 1},
+	{"timestamp2", colInt, 1},
+	{"date", colString, 1},
+	{"pid", colInt, 1},
+	{"cmdno", colInt, 1},
+	{"user", colString, 1},
+	{"client", colString, 1},
+	{"func", colString, 1},
+	{"host", colString, 1},
+	{"prog", colString, 1},
+	{"version", colString, 1},
+}
+
+var argsColumn = []column{{"args", colString, 1}}
+
+var commandEndColumns = []column{
+	{"lapse", colFloat, 1},
+	{"ucpu", colInt, 1},
+	{"scpu", colInt, 1},
+	{"disk_in", colInt, 1},
+	{"disk_out", colInt, 1},
+	{"ipc_in", colInt, 1},
+	{"ipc_out", colInt, 1},
+	{"max_rss", colInt, 1},
+	{"page_faults", colInt, 1},
+	{"rpc_msgs_in", colInt, 1},
+	{"rpc_msgs_out", colInt, 1},
+	{"rpc_size_in", colInt, 1},
+	{"rpc_size_out", colInt, 1},
+	{"rpc_himark_fwd", colInt, 1},
+	{"rpc_himark_rev", colInt, 1},
+	{"rpc_snd", colFloat, 2},
+	{"rpc_rcv", colFloat, 2},
+	{"running", colInt, 3},
+	{"error", colString, 3},
+}
+
+var errorColumns = []column{
+	{"severity", colInt, 1},
+	{"subsys", colInt, 1},
+	{"subcode", colInt, 1},
+	{"error_text", colString, 1},
+}
+
+var auditColumns = []column{
+	{"action", colString, 1},
+	{"file", colString, 1},
+	{"rev", colInt, 1},
+}
+
+var eventColumns = []column{
+	{"event", colString, 1},
+	{"text", colString, 1},
+}
+
+func concatColumns(parts ...[]column) []column {
+	cols := make([]column, 0)
+	for _, p := range parts {
+		cols = append(cols, p...)
+	}
+	return cols
+}
+
+// recordLayout is the layout for an event type
+type recordLayout struct {
+	name    string
+	columns []column
+}
+
+// structuredLog describes one of the structured log formats: the layouts of its event types,
+// and the layout used for any other event types found in it
+type structuredLog struct {
+	logType  string // used in field names: p4.structured.<logtype>.*
+	layouts  map[int]recordLayout
+	fallback recordLayout
+}
+
+var structuredLogs = map[string]structuredLog{
+	config.ParserStructuredCommands: {
+		logType: "commands",
+		layouts: map[int]recordLayout{
+			0: {"command_start", concatColumns(structuredHeader, argsColumn)},
+			1: {"command_compute", concatColumns(structuredHeader, argsColumn, []column{{"lapse", colFloat, 1}})},
+			2: {"command_end", concatColumns(structuredHeader, argsColumn, commandEndColumns)},
+		},
+		fallback: recordLayout{"command", concatColumns(structuredHeader, argsColumn)},
+	},
+	config.ParserStructuredErrors: {
+		logType: "errors",
+		layouts: map[int]recordLayout{
+			3: {"error_failed", concatColumns(structuredHeader, argsColumn, errorColumns)},
+			4: {"error_fatal", concatColumns(structuredHeader, argsColumn, errorColumns)},
+		},
+		fallback: recordLayout{"error", concatColumns(structuredHeader, argsColumn, errorColumns)},
+	},
+	config.ParserStructuredAudit: {
+		logType: "audit",
+		layouts: map[int]recordLayout{
+			5: {"audit", concatColumns(structuredHeader, auditColumns)},
+		},
+		fallback: recordLayout{"audit", concatColumns(structuredHeader, auditColumns)},
+	},
+	config.ParserStructuredEvents: {
+		logType:  "events",
+		layouts:  map[int]recordLayout{},
+		fallback: recordLayout{"event", concatColumns(structuredHeader, eventColumns)},
+	},
+}
+
+// isStructured is true if the input is one of the structured log formats
+func (in *logInput) isStructured() bool {
+	_, ok := structuredLogs[in.parser]
+	return ok
+}
+
+// structuredRecord is a decoded structured log record
+type structuredRecord struct {
+	logType string
+	time    time.Time // zero if the record has no timestamp
+	fields  common.MapStr
+}
+
+// parseStructured decodes a line from a structured log of the specified format into typed fields.
+// Columns beyond those known for the record version are kept as field_<n> (counting from 1).
+func parseStructured(parser, line string) (*structuredRecord, error) {
+	sl, ok := structuredLogs[parser]
+	if !ok {
+		return nil, fmt.Errorf("unknown structured log format '%s'", parser)
+	}
+	r := csv.NewReader(strings.NewReader(line))
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	values, err := r.Read()
+	if err != nil {
+		return nil, err
+	}
+	if len(values) < 2 {
+		return nil, fmt.Errorf("record has %d columns", len(values))
+	}
+	eventType, err := strconv.Atoi(values[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid event type '%s'", values[0])
+	}
+	version, err := strconv.Atoi(values[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid record version '%s'", values[1])
+	}
+	layout, ok := sl.layouts[eventType]
+	if !ok {
+		layout = sl.fallback
+	}
+
+	rec := &structuredRecord{logType: sl.logType, fields: common.MapStr{
+		"event_type":     eventType,
+		"event_name":     layout.name,
+		"record_version": version,
+	}}
+	values = values[2:]
+	i := 0
+	for _, col := range layout.columns {
+		if col.since > version {
+			continue
+		}
+		if i >= len(values) {
+			break
+		}
+		v := values[i]
+		i++
+		switch col.typ {
+		case colString:
+			rec.fields[col.name] = v
+		case colInt:
+			if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+				rec.fields[col.name] = n
+			} else if v != "" {
+				rec.fields[col.name] = v
+			}
+		case colFloat:
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				rec.fields[col.name] = f
+			} else if v != "" {
+				rec.fields[col.name] = v
+			}
+		case colUnixTime:
+			if n, err := strconv.ParseInt(v, 10, 64); err == nil && n > 0 {
+				rec.time = time.Unix(n, 0)
+				rec.fields[col.name] = rec.time
+			}
+		}
+	}
+	for ; i < len(values); i++ {
+		rec.fields[fmt.Sprintf("field_%d", i+3)] = values[i]
+	}
+	return rec, nil
+}
+
+// structuredID returns a document ID for the record so re-reading the log doesn't create duplicates
+func structuredID(serverID, logType, line string) string {
+	h := sha1.New()
+	fmt.Fprintf(h, "%s|%s|%s", serverID, logType, line)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// publishStructured decodes and publishes a structured log record as an event. private is passed back
+// to the ACK handler once the event has been acknowledged.
+// Invalid records are logged and skipped.
+func (bt *P4dbeat) publishStructured(in *logInput, line string, lineNo int64, private interface{}) {
+	if strings.TrimSpace(line) == "" {
+		return
+	}
+	rec, err := parseStructured(in.parser, line)
+	if err != nil {
+		bt.log.Warnf("Invalid record at line %d of '%s': %v", lineNo, in.path, err)
+		return
+	}
+	event := beat.Event{
+		Timestamp: bt.eventTimestamp(rec.time, rec.time),
+		Private:   private,
+		Fields: common.MapStr{
+			"type":       bt.name,
+			"p4.line_no": lineNo,
+		},
+	}
+	prefix := fmt.Sprintf("p4.structured.%s.", rec.logType)
+	for k, v := range rec.fields {
+		event.Fields[prefix+k] = v
+	}
+	if bt.privacy != nil {
+		bt.privacy.structured(&event, prefix)
+	}
+	for k, v := range in.fields {
+		event.Fields[k] = v
+	}
+	if bt.config.DeterministicID {
+		event.SetID(structuredID(in.serverID(), rec.logType, line))
+	}
+	bt.publish(event)
+}
@@ -0,0 +1,158 @@
+//go:build !integration
+// +build !integration
+
+package beater
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/rcowham/p4dbeat/config"
+)
+
+// Columns common to all records after the event type and record version
+const structuredTestHeader = "1536228000,123456789,2018/09/06 10:00:00 123456789,1234,1,fred,fred_ws,user-sync,10.0.0.1,p4,2018.1/LINUX26X86_64/1660568"
+
+func structuredTestFields(fields common.MapStr) common.MapStr {
+	f := common.MapStr{
+		"timestamp":  time.Unix(1536228000, 0),
+		"timestamp2": int64(123456789),
+		"date":       "2018/09/06 10:00:00 123456789",
+		"pid":        int64(1234),
+		"cmdno":      int64(1),
+		"user":       "fred",
+		"client":     "fred_ws",
+		"func":       "user-sync",
+		"host":       "10.0.0.1",
+		"prog":       "p4",
+		"version":    "2018.1/LINUX26X86_64/1660568",
+	}
+	for k, v := range fields {
+		f[k] = v
+	}
+	return f
+}
+
+func TestParseStructured(t *testing.T) {
+	commandEnd := common.MapStr{
+		"args": "//depot/...", "lapse": 1.5, "ucpu": int64(10), "scpu": int64(20),
+		"disk_in": int64(30), "disk_out": int64(40), "ipc_in": int64(0), "ipc_out": int64(0),
+		"max_rss": int64(5000), "page_faults": int64(1), "rpc_msgs_in": int64(2), "rpc_msgs_out": int64(3),
+		"rpc_size_in": int64(400), "rpc_size_out": int64(500), "rpc_himark_fwd": int64(2000), "rpc_himark_rev": int64(2000),
+	}
+	commandEndV1 := "//depot/...,1.5,10,20,30,40,0,0,5000,1,2,3,400,500,2000,2000"
+	withFields := func(base common.MapStr, extra common.MapStr) common.MapStr {
+		f := common.MapStr{}
+		for k, v := range base {
+			f[k] = v
+		}
+		for k, v := range extra {
+			f[k] = v
+		}
+		return f
+	}
+	tests := []struct {
+		name    string
+		parser  string
+		line    string
+		logType string
+		want    common.MapStr // without the header columns
+	}{
+		{"command start", config.ParserStructuredCommands, "0,1," + structuredTestHeader + ",//depot/...", "commands",
+			common.MapStr{"event_type": 0, "event_name": "command_start", "record_version": 1, "args": "//depot/..."}},
+		{"command compute", config.ParserStructuredCommands, "1,1," + structuredTestHeader + ",//depot/...,0.25", "commands",
+			common.MapStr{"event_type": 1, "event_name": "command_compute", "record_version": 1, "args": "//depot/...", "lapse": 0.25}},
+		{"command end v1", config.ParserStructuredCommands, "2,1," + structuredTestHeader + "," + commandEndV1, "commands",
+			withFields(commandEnd, common.MapStr{"event_type": 2, "event_name": "command_end", "record_version": 1})},
+		{"command end v2", config.ParserStructuredCommands, "2,2," + structuredTestHeader + "," + commandEndV1 + ",0.1,0.2", "commands",
+			withFields(commandEnd, common.MapStr{"event_type": 2, "event_name": "command_end", "record_version": 2,
+				"rpc_snd": 0.1, "rpc_rcv": 0.2})},
+		{"command end v3", config.ParserStructuredCommands, "2,3," + structuredTestHeader + "," + commandEndV1 + ",0.1,0.2,1,", "commands",
+			withFields(commandEnd, common.MapStr{"event_type": 2, "event_name": "command_end", "record_version": 3,
+				"rpc_snd": 0.1, "rpc_rcv": 0.2, "running": int64(1), "error": ""})},
+		// Columns added in later versions are ignored for earlier ones, so v1 records with extra
+		// columns keep them as field_<n>
+		{"command end v1 extra columns", config.ParserStructuredCommands, "2,1," + structuredTestHeader + "," + commandEndV1 + ",0.1,x", "commands",
+			withFields(commandEnd, common.MapStr{"event_type": 2, "event_name": "command_end", "record_version": 1,
+				"field_30": "0.1", "field_31": "x"})},
+		{"unknown command event", config.ParserStructuredCommands, "9,1," + structuredTestHeader + ",//depot/...", "commands",
+			common.MapStr{"event_type": 9, "event_name": "command", "record_version": 1, "args": "//depot/..."}},
+		{"short record", config.ParserStructuredCommands, "2,3," + structuredTestHeader + ",//depot/...,1.5", "commands",
+			common.MapStr{"event_type": 2, "event_name": "command_end", "record_version": 3, "args": "//depot/...", "lapse": 1.5}},
+		{"quoted args", config.ParserStructuredCommands, "0,1," + structuredTestHeader + `,"-d ""a, b"" //depot/..."`, "commands",
+			common.MapStr{"event_type": 0, "event_name": "command_start", "record_version": 1, "args": `-d "a, b" //depot/...`}},
+		{"error failed", config.ParserStructuredErrors, "3,1," + structuredTestHeader + ",//depot/...,3,6,17,File(s) not on client.", "errors",
+			common.MapStr{"event_type": 3, "event_name": "error_failed", "record_version": 1, "args": "//depot/...",
+				"severity": int64(3), "subsys": int64(6), "subcode": int64(17), "error_text": "File(s) not on client."}},
+		{"error fatal", config.ParserStructuredErrors, "4,1," + structuredTestHeader + ",,4,0,1,Connection lost", "errors",
+			common.MapStr{"event_type": 4, "event_name": "error_fatal", "record_version": 1, "args": "",
+				"severity": int64(4), "subsys": int64(0), "subcode": int64(1), "error_text": "Connection lost"}},
+		{"non numeric severity", config.ParserStructuredErrors, "3,1," + structuredTestHeader + ",,E_FAILED", "errors",
+			common.MapStr{"event_type": 3, "event_name": "error_failed", "record_version": 1, "args": "", "severity": "E_FAILED"}},
+		{"audit", config.ParserStructuredAudit, "5,1," + structuredTestHeader + ",sync,//depot/main/a.c,3", "audit",
+			common.MapStr{"event_type": 5, "event_name": "audit", "record_version": 1,
+				"action": "sync", "file": "//depot/main/a.c", "rev": int64(3)}},
+		{"event", config.ParserStructuredEvents, "11,1," + structuredTestHeader + ",startup,Server started", "events",
+			common.MapStr{"event_type": 11, "event_name": "event", "record_version": 1, "event": "startup", "text": "Server started"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, err := parseStructured(tt.parser, tt.line)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rec.logType != tt.logType {
+				t.Errorf("log type %s, want %s", rec.logType, tt.logType)
+			}
+			if !rec.time.Equal(time.Unix(1536228000, 0)) {
+				t.Errorf("time %v, want %v", rec.time, time.Unix(1536228000, 0))
+			}
+			want := structuredTestFields(tt.want)
+			if !reflect.DeepEqual(rec.fields, want) {
+				for k, v := range want {
+					if got, ok := rec.fields[k]; !ok || !reflect.DeepEqual(got, v) {
+						t.Errorf("%s = %#v, want %#v", k, got, v)
+					}
+				}
+				for k, v := range rec.fields {
+					if _, ok := want[k]; !ok {
+						t.Errorf("unexpected %s = %#v", k, v)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestParseStructuredShortHeader(t *testing.T) {
+	// Records ending within the header just have the columns present, and no time
+	rec, err := parseStructured(config.ParserStructuredCommands, "0,1,0,5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := common.MapStr{"event_type": 0, "event_name": "command_start", "record_version": 1, "timestamp2": int64(5)}
+	if !reflect.DeepEqual(rec.fields, want) || !rec.time.IsZero() {
+		t.Errorf("fields %v time %v, want %v and no time", rec.fields, rec.time, want)
+	}
+}
+
+func TestParseStructuredInvalid(t *testing.T) {
+	tests := []struct {
+		parser string
+		line   string
+		want   string
+	}{
+		{"unknown", "0,1", "unknown structured log format"},
+		{config.ParserStructuredCommands, "0", "record has 1 columns"},
+		{config.ParserStructuredCommands, "x,1,2", "invalid event type"},
+		{config.ParserStructuredCommands, "0,y,2", "invalid record version"},
+	}
+	for _, tt := range tests {
+		if _, err := parseStructured(tt.parser, tt.line); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("parseStructured(%q, %q) error %v, want %q", tt.parser, tt.line, err, tt.want)
+		}
+	}
+}
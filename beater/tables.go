@@ -0,0 +1,40 @@
+package beater
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/rcowham/p4dbeat/config"
+)
+
+// tableFilter decides which tables have p4.tbl.<table>.* fields published. Each table adds
+// up to 25 fields to the index, so only the tables of interest can be published.
+type tableFilter struct {
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+// newTableFilter returns nil if all tables are published
+func newTableFilter(cfg config.Tables) (*tableFilter, error) {
+	if len(cfg.Include) == 0 && len(cfg.Exclude) == 0 {
+		return nil, nil
+	}
+	f := &tableFilter{}
+	var err error
+	if f.include, err = compilePatterns(cfg.Include); err != nil {
+		return nil, err
+	}
+	if f.exclude, err = compilePatterns(cfg.Exclude); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// publish returns true if the fields of the table should be published
+func (f *tableFilter) publish(table string) bool {
+	table = strings.ToLower(table)
+	if !matchAnyPattern(f.include, table) {
+		return false
+	}
+	return len(f.exclude) == 0 || !matchAnyPattern(f.exclude, table)
+}
@@ -0,0 +1,72 @@
+//go:build !integration
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+	"time"
+
+	p4dlog "github.com/rcowham/go-libp4dlog"
+	"github.com/rcowham/p4dbeat/config"
+)
+
+func TestTableFilter(t *testing.T) {
+	tables := []string{"rev", "revsh", "db.have", "have", "TRAITS"}
+	tests := []struct {
+		name   string
+		tables config.Tables
+		want   []bool // published, for each table
+	}{
+		{"include", config.Tables{Include: []string{"rev", "have"}}, []bool{true, false, false, true, false}},
+		{"include regex", config.Tables{Include: []string{"rev.*"}}, []bool{true, true, false, false, false}},
+		{"exclude", config.Tables{Exclude: []string{"rev.+", "traits"}}, []bool{true, false, true, true, false}},
+		{"include less exclude", config.Tables{Include: []string{"rev.*", "have"}, Exclude: []string{"revsh"}},
+			[]bool{true, false, false, true, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := newTableFilter(tt.tables)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i, table := range tables {
+				if got := f.publish(table); got != tt.want[i] {
+					t.Errorf("%s published %v, want %v", table, got, tt.want[i])
+				}
+			}
+		})
+	}
+	if f, err := newTableFilter(config.Tables{}); f != nil || err != nil {
+		t.Errorf("filter %v, %v when none configured", f, err)
+	}
+	if _, err := newTableFilter(config.Tables{Exclude: []string{"rev("}}); err == nil {
+		t.Error("no error for invalid pattern")
+	}
+}
+
+func TestPublishTables(t *testing.T) {
+	bt := newTestBeat()
+	var err error
+	if bt.tables, err = newTableFilter(config.Tables{Include: []string{"rev"}}); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2018, 9, 2, 10, 0, 0, 0, time.UTC)
+	in := bt.newLogInput(config.Input{Path: "log"}, "log", "log")
+	bt.publishCommand(in, p4dlog.Command{Cmd: "user-sync", User: "fred", Pid: 1, StartTime: start, EndTime: start,
+		Tables: map[string]*p4dlog.Table{
+			"rev":  {TableName: "rev", PagesIn: 5, TotalReadHeld: 1500},
+			"have": {TableName: "have", PagesIn: 3},
+		}}, nil)
+	events := bt.published()
+	if len(events) != 1 {
+		t.Fatalf("%d events, want 1", len(events))
+	}
+	fields := events[0].Fields
+	if fields["p4.tbl.rev.pages.in"] != int64(5) || fields["p4.tbl.rev.locks.read.held.total_sec"] != 1.5 {
+		t.Errorf("rev fields missing: %v", fields)
+	}
+	if _, ok := fields["p4.tbl.have.pages.in"]; ok {
+		t.Errorf("excluded table published: %v", fields)
+	}
+}
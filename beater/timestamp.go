@@ -0,0 +1,122 @@
+package beater
+
+import (
+	"fmt"
+	"time"
+
+	p4dlog "github.com/rcowham/go-libp4dlog"
+	"github.com/rcowham/p4dbeat/config"
+)
+
+// logClock converts times from the log, which are p4d server local time but parsed as UTC,
+// into the server's timezone. When clocks go back the same local time occurs twice, so we
+// keep track of the latest time seen to decide which one is meant.
+type logClock struct {
+	loc    *time.Location
+	latest time.Time
+}
+
+func newLogClock(loc *time.Location) *logClock {
+	return &logClock{loc: loc}
+}
+
+// convert returns the time for the wall clock time t (parsed as UTC) in the clock's timezone.
+// If the wall clock time is ambiguous (clocks going back), the candidate nearest to near is chosen,
+// or nearest to the latest time seen if near is zero.
+func (c *logClock) convert(t time.Time, near time.Time) time.Time {
+	if t.IsZero() || c.loc == time.UTC {
+		return t
+	}
+	// Candidates are the wall clock time with the offset in force either side of it. If the
+	// wall clock time doesn't map back to itself with an offset, it isn't valid for that offset.
+	candidates := make([]time.Time, 0, 2)
+	local := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), c.loc)
+	for _, d := range []time.Duration{-3 * time.Hour, 3 * time.Hour} {
+		_, offset := local.Add(d).Zone()
+		ct := t.Add(-time.Duration(offset) * time.Second)
+		if ct.In(c.loc).Format(p4timeformat) != t.Format(p4timeformat) {
+			continue
+		}
+		if len(candidates) == 0 || !candidates[0].Equal(ct) {
+			candidates = append(candidates, ct)
+		}
+	}
+	switch len(candidates) {
+	case 0: // in the gap when clocks go forward - shouldn't happen
+		return local
+	case 1:
+		return candidates[0]
+	}
+	if near.IsZero() {
+		near = c.latest
+	}
+	if near.IsZero() {
+		if candidates[0].Before(candidates[1]) {
+			return candidates[0]
+		}
+		return candidates[1]
+	}
+	if absDuration(candidates[0].Sub(near)) <= absDuration(candidates[1].Sub(near)) {
+		return candidates[0]
+	}
+	return candidates[1]
+}
+
+// observe records a time seen in the log
+func (c *logClock) observe(t time.Time) {
+	if t.After(c.latest) {
+		c.latest = t
+	}
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// GO standard reference value/format: Mon Jan 2 15:04:05 -0700 MST 2006
+const p4timeformat = "2006/01/02 15:04:05"
+
+// localiseTimes converts the command start/end times to the timezone of the server.
+// Commands are output roughly in order of completion, so end time is converted first
+// and the start time is then resolved using the command's lapse time.
+func (in *logInput) localiseTimes(command *p4dlog.Command) {
+	command.EndTime = in.clock.convert(command.EndTime, time.Time{})
+	var near time.Time
+	if !command.EndTime.IsZero() {
+		near = command.EndTime.Add(-time.Duration(command.CompletedLapse * float32(time.Second)))
+	}
+	command.StartTime = in.clock.convert(command.StartTime, near)
+	in.clock.observe(command.StartTime)
+	in.clock.observe(command.EndTime)
+}
+
+// eventTimestamp returns the timestamp for the event as configured
+func (bt *P4dbeat) eventTimestamp(start, end time.Time) time.Time {
+	switch bt.config.Timestamp {
+	case config.TimestampEnd:
+		if !end.IsZero() {
+			return end
+		}
+		fallthrough
+	case config.TimestampStart:
+		if !start.IsZero() {
+			return start
+		}
+	}
+	return time.Now()
+}
+
+// parseJSONTime parses a time from a JSON record, either in p4d log format (server local time) or RFC3339
+func parseJSONTime(s string, loc *time.Location) (time.Time, error) {
+	if t, err := time.ParseInLocation(p4timeformat, s, loc); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return t, fmt.Errorf("invalid time '%s' - must be YYYY/MM/DD HH:MM:SS or RFC3339", s)
+	}
+	return t, nil
+}
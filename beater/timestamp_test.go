@@ -0,0 +1,98 @@
+//go:build !integration
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+	"time"
+
+	p4dlog "github.com/rcowham/go-libp4dlog"
+)
+
+// wallClock returns the log time as parsed by p4dlog (local time parsed as UTC)
+func wallClock(s string) time.Time {
+	t, err := time.Parse(p4timeformat, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func utc(s string) time.Time {
+	return wallClock(s).UTC()
+}
+
+func TestLogClockConvert(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skipf("no timezone data: %v", err)
+	}
+	// In London clocks went back from 02:00 BST to 01:00 GMT on 2018/10/28, so 01:00-02:00 happened twice
+	tests := []struct {
+		name   string
+		loc    *time.Location
+		t      string
+		near   time.Time
+		latest time.Time
+		want   time.Time
+	}{
+		{"utc", time.UTC, "2018/07/01 12:00:00", time.Time{}, time.Time{}, utc("2018/07/01 12:00:00")},
+		{"summer", london, "2018/07/01 12:00:00", time.Time{}, time.Time{}, utc("2018/07/01 11:00:00")},
+		{"winter", london, "2018/01/01 12:00:00", time.Time{}, time.Time{}, utc("2018/01/01 12:00:00")},
+		{"before clocks go back", london, "2018/10/28 00:59:59", time.Time{}, time.Time{}, utc("2018/10/27 23:59:59")},
+		{"after clocks go back", london, "2018/10/28 02:00:00", time.Time{}, time.Time{}, utc("2018/10/28 02:00:00")},
+		{"ambiguous defaults to first", london, "2018/10/28 01:30:00", time.Time{}, time.Time{}, utc("2018/10/28 00:30:00")},
+		{"ambiguous near first", london, "2018/10/28 01:30:00", utc("2018/10/28 00:20:00"), time.Time{}, utc("2018/10/28 00:30:00")},
+		{"ambiguous near second", london, "2018/10/28 01:30:00", utc("2018/10/28 01:20:00"), time.Time{}, utc("2018/10/28 01:30:00")},
+		{"ambiguous latest first", london, "2018/10/28 01:30:00", time.Time{}, utc("2018/10/28 00:50:00"), utc("2018/10/28 00:30:00")},
+		{"ambiguous latest second", london, "2018/10/28 01:30:00", time.Time{}, utc("2018/10/28 01:10:00"), utc("2018/10/28 01:30:00")},
+		{"near overrides latest", london, "2018/10/28 01:30:00", utc("2018/10/28 00:20:00"), utc("2018/10/28 01:10:00"), utc("2018/10/28 00:30:00")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newLogClock(tt.loc)
+			c.observe(tt.latest)
+			got := c.convert(wallClock(tt.t), tt.near)
+			if !got.Equal(tt.want) {
+				t.Errorf("convert(%s) = %v, want %v", tt.t, got.UTC(), tt.want)
+			}
+		})
+	}
+}
+
+func TestLocaliseTimes(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skipf("no timezone data: %v", err)
+	}
+	tests := []struct {
+		name      string
+		start     string
+		end       string
+		lapse     float32
+		latest    time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{"summer", "2018/07/01 12:00:00", "2018/07/01 12:00:05", 5,
+			time.Time{}, utc("2018/07/01 11:00:00"), utc("2018/07/01 11:00:05")},
+		// Started at 01:59:50 BST and ended at 01:00:10 GMT, after clocks went back
+		{"over clocks going back", "2018/10/28 01:59:50", "2018/10/28 01:00:10", 20,
+			utc("2018/10/28 00:59:50"), utc("2018/10/28 00:59:50"), utc("2018/10/28 01:00:10")},
+		// Both in the second 01:00-02:00, as the latest time seen is
+		{"after clocks go back", "2018/10/28 01:30:00", "2018/10/28 01:30:10", 10,
+			utc("2018/10/28 01:20:00"), utc("2018/10/28 01:30:00"), utc("2018/10/28 01:30:10")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := &logInput{clock: newLogClock(london)}
+			in.clock.observe(tt.latest)
+			command := p4dlog.Command{StartTime: wallClock(tt.start), EndTime: wallClock(tt.end), CompletedLapse: tt.lapse}
+			in.localiseTimes(&command)
+			if !command.StartTime.Equal(tt.wantStart) || !command.EndTime.Equal(tt.wantEnd) {
+				t.Errorf("start %v end %v, want start %v end %v", command.StartTime.UTC(), command.EndTime.UTC(), tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
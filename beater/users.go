@@ -0,0 +1,368 @@
+package beater
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/csv"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/rcowham/p4dbeat/config"
+	"github.com/sirupsen/logrus"
+)
+
+// Default field names for CSV columns, LDIF attributes and ztag fields (lower case).
+// Anything else is published under its own (lower case) name for CSV, and ignored otherwise.
+var defaultUserAttributes = map[string]string{
+	"fullname":     "full_name",
+	"cn":           "full_name",
+	"displayname":  "full_name",
+	"email":        "email",
+	"mail":         "email",
+	"department":   "department",
+	"manager":      "manager",
+	"team":         "team",
+	"title":        "title",
+	"type":         "account_type",
+	"employeetype": "account_type",
+	"account_type": "account_type",
+}
+
+// p4 user types mapped to account types
+var p4UserTypes = map[string]string{
+	"standard": "human",
+	"operator": "service",
+	"service":  "service",
+}
+
+// Matches a line of p4 -ztag output, e.g. "... User fred"
+var reZtagLine = regexp.MustCompile(`^\.\.\. (\S+) ?(.*)$`)
+
+// userInfo is the attributes and groups of a user
+type userInfo struct {
+	attrs  map[string]string
+	groups []string
+}
+
+// userDirectory enriches events with the attributes of the user from local files. The
+// users are swapped in atomically when the files are reloaded, so lookups never wait.
+type userDirectory struct {
+	cfg        config.Users
+	attributes map[string]string
+	users      atomic.Value // map[string]*userInfo by lower case user
+	modified   map[string]time.Time
+	log        *logrus.Logger
+}
+
+// newUserDirectory returns nil if no files are configured. Files which can't be read are
+// logged and retried when they change.
+func newUserDirectory(cfg config.Users, log *logrus.Logger) *userDirectory {
+	if len(cfg.Files) == 0 {
+		return nil
+	}
+	d := &userDirectory{
+		cfg:        cfg,
+		attributes: make(map[string]string),
+		modified:   make(map[string]time.Time),
+		log:        log,
+	}
+	for k, v := range defaultUserAttributes {
+		d.attributes[k] = v
+	}
+	for k, v := range cfg.Attributes {
+		d.attributes[strings.ToLower(k)] = v
+	}
+	d.users.Store(make(map[string]*userInfo))
+	d.reload()
+	return d
+}
+
+// run reloads the files when they change, until done is closed
+func (d *userDirectory) run(done <-chan struct{}) {
+	interval := d.cfg.ReloadInterval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if d.changed() {
+				d.reload()
+			}
+		}
+	}
+}
+
+// changed returns true if any of the files have been modified since they were loaded
+func (d *userDirectory) changed() bool {
+	for _, f := range d.cfg.Files {
+		info, err := os.Stat(f.Path)
+		if err != nil {
+			continue
+		}
+		if !info.ModTime().Equal(d.modified[f.Path]) {
+			return true
+		}
+	}
+	return false
+}
+
+// reload loads all the files, keeping the current users if none of them could be loaded
+func (d *userDirectory) reload() {
+	users := make(map[string]*userInfo)
+	loaded := 0
+	for _, f := range d.cfg.Files {
+		info, err := os.Stat(f.Path)
+		if err == nil {
+			d.modified[f.Path] = info.ModTime()
+			err = d.loadFile(f, users)
+		}
+		if err != nil {
+			d.log.Warnf("Failed to load users from '%s': %v", f.Path, err)
+			continue
+		}
+		loaded++
+	}
+	if loaded == 0 {
+		return
+	}
+	for _, u := range users {
+		u.groups = uniqueStrings(u.groups)
+	}
+	d.users.Store(users)
+	d.log.Infof("Loaded %d users from %d files", len(users), loaded)
+}
+
+func (d *userDirectory) loadFile(f config.UsersFile, users map[string]*userInfo) error {
+	file, err := os.Open(f.Path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	format := f.Format
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(f.Path)) {
+		case ".csv":
+			format = config.UsersCSV
+		case ".ldif":
+			format = config.UsersLDIF
+		default:
+			format = config.UsersZtag
+		}
+	}
+	switch format {
+	case config.UsersCSV:
+		return d.loadCSV(file, users)
+	case config.UsersLDIF:
+		return d.loadLDIF(file, users)
+	}
+	return d.loadZtag(file, users)
+}
+
+func userEntry(users map[string]*userInfo, user string) *userInfo {
+	key := strings.ToLower(user)
+	u, ok := users[key]
+	if !ok {
+		u = &userInfo{attrs: make(map[string]string)}
+		users[key] = u
+	}
+	return u
+}
+
+// loadCSV loads a CSV file with a header row - the "user" column is the user and other
+// columns are attributes
+func (d *userDirectory) loadCSV(r io.Reader, users map[string]*userInfo) error {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	records, err := cr.ReadAll()
+	if err != nil || len(records) == 0 {
+		return err
+	}
+	header := records[0]
+	userCol := -1
+	for i, h := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(h))
+		if header[i] == "user" {
+			userCol = i
+		}
+	}
+	if userCol < 0 {
+		userCol = 0
+	}
+	for _, rec := range records[1:] {
+		if userCol >= len(rec) || rec[userCol] == "" {
+			continue
+		}
+		u := userEntry(users, rec[userCol])
+		for i, v := range rec {
+			if i == userCol || i >= len(header) || v == "" {
+				continue
+			}
+			name, ok := d.attributes[header[i]]
+			if !ok {
+				name = strings.Replace(header[i], " ", "_", -1)
+			}
+			if name == "groups" {
+				u.groups = append(u.groups, strings.Fields(strings.Replace(v, ",", " ", -1))...)
+				continue
+			}
+			u.attrs[name] = v
+		}
+	}
+	return nil
+}
+
+// loadZtag loads p4 -ztag users (User, FullName, Email, Type...) and/or p4 -ztag groups
+// (user, group, isUser) records, which are separated by blank lines
+func (d *userDirectory) loadZtag(r io.Reader, users map[string]*userInfo) error {
+	rec := make(map[string]string)
+	flush := func() {
+		defer func() { rec = make(map[string]string) }()
+		if user, ok := rec["User"]; ok {
+			u := userEntry(users, user)
+			for k, v := range rec {
+				name, ok := d.attributes[strings.ToLower(k)]
+				if !ok || v == "" {
+					continue
+				}
+				if k == "Type" {
+					if t, ok := p4UserTypes[v]; ok {
+						v = t
+					}
+				}
+				u.attrs[name] = v
+			}
+		} else if user, ok := rec["user"]; ok && rec["group"] != "" && rec["isUser"] != "0" {
+			u := userEntry(users, user)
+			u.groups = append(u.groups, rec["group"])
+		}
+	}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			flush()
+			continue
+		}
+		if m := reZtagLine.FindStringSubmatch(line); len(m) > 0 {
+			if _, ok := rec[m[1]]; ok && (m[1] == "User" || m[1] == "user") {
+				flush() // records not separated by blank lines
+			}
+			rec[m[1]] = m[2]
+		}
+	}
+	flush()
+	return scanner.Err()
+}
+
+// loadLDIF loads LDAP entries - the user is uid (or sAMAccountName) and memberOf entries are
+// the groups. DNs (e.g. of the manager) are reduced to their first value.
+func (d *userDirectory) loadLDIF(r io.Reader, users map[string]*userInfo) error {
+	entry := make(map[string][]string)
+	flush := func() {
+		defer func() { entry = make(map[string][]string) }()
+		user := ""
+		if v := entry["uid"]; len(v) > 0 {
+			user = v[0]
+		} else if v := entry["samaccountname"]; len(v) > 0 {
+			user = v[0]
+		}
+		if user == "" {
+			return
+		}
+		u := userEntry(users, user)
+		for k, values := range entry {
+			if k == "memberof" {
+				for _, v := range values {
+					u.groups = append(u.groups, dnValue(v))
+				}
+				continue
+			}
+			if name, ok := d.attributes[k]; ok {
+				u.attrs[name] = dnValue(values[0])
+			}
+		}
+	}
+	var attr, value string
+	addAttr := func() {
+		if attr == "" {
+			return
+		}
+		if strings.HasPrefix(value, ":") { // base64 encoded
+			if b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value[1:])); err == nil {
+				value = string(b)
+			}
+		}
+		entry[strings.ToLower(attr)] = append(entry[strings.ToLower(attr)], strings.TrimSpace(value))
+		attr, value = "", ""
+	}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		switch {
+		case line == "":
+			addAttr()
+			flush()
+		case strings.HasPrefix(line, "#"):
+		case strings.HasPrefix(line, " "): // continuation of the previous line
+			value += line[1:]
+		default:
+			addAttr()
+			if i := strings.Index(line, ":"); i > 0 {
+				attr, value = line[:i], line[i+1:]
+			}
+		}
+	}
+	addAttr()
+	flush()
+	return scanner.Err()
+}
+
+// dnValue returns the first value of a DN, e.g. "Fred Bloggs" from "cn=Fred Bloggs,ou=people,dc=example,dc=com".
+// Values which aren't DNs are returned as is.
+func dnValue(v string) string {
+	first := strings.SplitN(v, ",", 2)[0]
+	if i := strings.Index(first, "="); i > 0 && i < len(first)-1 && strings.Contains(v, ",") {
+		return first[i+1:]
+	}
+	return v
+}
+
+// uniqueStrings returns the sorted values without duplicates
+func uniqueStrings(values []string) []string {
+	sort.Strings(values)
+	unique := values[:0]
+	for i, v := range values {
+		if i == 0 || v != values[i-1] {
+			unique = append(unique, v)
+		}
+	}
+	return unique
+}
+
+// enrich adds the attributes and groups of the user to the event
+func (d *userDirectory) enrich(event *beat.Event, user string) {
+	users := d.users.Load().(map[string]*userInfo)
+	u, ok := users[strings.ToLower(user)]
+	if !ok {
+		return
+	}
+	for k, v := range u.attrs {
+		event.Fields["p4.user_"+k] = v
+	}
+	if len(u.groups) > 0 {
+		event.Fields["p4.user_groups"] = u.groups
+	}
+}
@@ -0,0 +1,139 @@
+//go:build !integration
+// +build !integration
+
+package beater
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/rcowham/p4dbeat/config"
+	"github.com/sirupsen/logrus"
+)
+
+// newTestUserDirectory writes the files (name to content) and loads them in order
+func newTestUserDirectory(t *testing.T, attributes map[string]string, files ...string) *userDirectory {
+	log := logrus.New()
+	log.Out = ioutil.Discard
+	dir := t.TempDir()
+	cfg := config.Users{Attributes: attributes}
+	for i := 0; i < len(files); i += 2 {
+		path := filepath.Join(dir, files[i])
+		if err := ioutil.WriteFile(path, []byte(files[i+1]), 0644); err != nil {
+			t.Fatal(err)
+		}
+		cfg.Files = append(cfg.Files, config.UsersFile{Path: path})
+	}
+	return newUserDirectory(cfg, log)
+}
+
+func userFields(d *userDirectory, user string) common.MapStr {
+	event := beat.Event{Fields: common.MapStr{}}
+	d.enrich(&event, user)
+	return event.Fields
+}
+
+func TestLoadUsers(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes map[string]string
+		files      []string // name, content
+		want       map[string]common.MapStr
+	}{
+		{"ztag users", nil, []string{"users.txt", "... User fred\n... Email fred@example.com\n... Update 2020/01/01 10:00:00\n" +
+			"... FullName Fred Bloggs\n... Type standard\n\n... User Build\n... Email build@example.com\n... FullName\n... Type operator\n"},
+			map[string]common.MapStr{
+				"fred":  {"p4.user_email": "fred@example.com", "p4.user_full_name": "Fred Bloggs", "p4.user_account_type": "human"},
+				"build": {"p4.user_email": "build@example.com", "p4.user_account_type": "service"},
+				"bill":  {},
+			}},
+		{"ztag groups", nil, []string{"groups.txt", "... user fred\n... group dev\n... isUser 1\n\n" +
+			"... user qa\n... group dev\n... isUser 0\n\n" + // subgroup
+			"... user fred\r\n... group admins\r\n... isUser 1\r\n" + // CRLF, no blank line
+			"... user bill\n... group dev\n... isUser 1\n"},
+			map[string]common.MapStr{
+				"fred": {"p4.user_groups": []string{"admins", "dev"}},
+				"bill": {"p4.user_groups": []string{"dev"}},
+				"qa":   {},
+			}},
+		{"ldif", map[string]string{"ou": "team"}, []string{"users.ldif", "# people\n" +
+			"dn: uid=fred,ou=people,dc=example,dc=com\nuid: fred\ncn: Fred Bloggs\nmail: fred@example.com\n" +
+			"manager: cn=Jane Smith,ou=people,dc=example,dc=com\nou: build\ndepartment: Release Eng\n ineering\n" +
+			"title:: QnVpbGQgRW5naW5lZXI=\n" +
+			"memberOf: cn=dev,ou=groups,dc=example,dc=com\nmemberOf: cn=admins,ou=groups,dc=example,dc=com\n\n" +
+			"dn: CN=Bill,OU=people,DC=example,DC=com\r\nsAMAccountName: Bill\r\nmail: bill@example.com\r\n\r\n" +
+			"dn: ou=people,dc=example,dc=com\nou: people\n"},
+			map[string]common.MapStr{
+				"fred": {"p4.user_full_name": "Fred Bloggs", "p4.user_email": "fred@example.com", "p4.user_manager": "Jane Smith",
+					"p4.user_team": "build", "p4.user_department": "Release Engineering", "p4.user_title": "Build Engineer",
+					"p4.user_groups": []string{"admins", "dev"}},
+				"bill": {"p4.user_email": "bill@example.com"},
+			}},
+		{"csv", nil, []string{"users.csv", "Email,User,Full Name,Groups,Location\n" +
+			"fred@example.com,fred,Fred Bloggs,\"dev, admins\",London\nbill@example.com,Bill\n,,Nobody\n"},
+			map[string]common.MapStr{
+				"fred": {"p4.user_email": "fred@example.com", "p4.user_full_name": "Fred Bloggs", "p4.user_location": "London",
+					"p4.user_groups": []string{"admins", "dev"}},
+				"bill": {"p4.user_email": "bill@example.com"},
+			}},
+		{"later files override", nil, []string{
+			"users.txt", "... User fred\n... Email fred@example.com\n... FullName Fred\n",
+			"users.csv", "user,email,department\nfred,fred@example.org,QA\n"},
+			map[string]common.MapStr{
+				"fred": {"p4.user_email": "fred@example.org", "p4.user_full_name": "Fred", "p4.user_department": "QA"},
+			}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newTestUserDirectory(t, tt.attributes, tt.files...)
+			for user, want := range tt.want {
+				if got := userFields(d, user); got.String() != want.String() {
+					t.Errorf("%s: %v, want %v", user, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestUserDirectoryReload(t *testing.T) {
+	// Users are kept if the files can't be loaded, and replaced when they change
+	d := newTestUserDirectory(t, nil, "users.csv", "user,email\nfred,fred@example.com\n")
+	path := d.cfg.Files[0].Path
+	if err := ioutil.WriteFile(path, []byte("user,email\n\"fred\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	d.reload()
+	if got := userFields(d, "fred"); got["p4.user_email"] != "fred@example.com" {
+		t.Errorf("users lost after failed reload: %v", got)
+	}
+	if err := ioutil.WriteFile(path, []byte("user,email\nbill,bill@example.com\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	d.reload()
+	if got := userFields(d, "fred"); len(got) != 0 {
+		t.Errorf("removed user still enriched: %v", got)
+	}
+	if got := userFields(d, "bill"); got["p4.user_email"] != "bill@example.com" {
+		t.Errorf("new user not enriched: %v", got)
+	}
+}
+
+func TestDNValue(t *testing.T) {
+	tests := []struct {
+		v, want string
+	}{
+		{"cn=Jane Smith,ou=people,dc=example,dc=com", "Jane Smith"},
+		{"CN=dev,OU=groups", "dev"},
+		{"Jane Smith", "Jane Smith"},
+		{"a=b", "a=b"},
+		{"Smith, Jane", "Smith, Jane"},
+	}
+	for _, tt := range tests {
+		if got := dnValue(tt.v); got != tt.want {
+			t.Errorf("dnValue(%q) = %q, want %q", tt.v, got, tt.want)
+		}
+	}
+}
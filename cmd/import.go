@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/elastic/beats/v7/libbeat/cfgfile"
+	"github.com/elastic/beats/v7/libbeat/cmd/instance"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/rcowham/p4dbeat/beater"
+)
+
+// genImportCmd - imports archived p4d logs (plain, gzip or bzip2) and exits when all
+// commands have been published. Uses the normal config file for outputs etc.
+func genImportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import <files...>",
+		Short: "Import archived p4d logs and exit",
+		Args:  cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			settings := instance.Settings{
+				Name: Name,
+				ConfigOverrides: []cfgfile.ConditionalOverride{{
+					Check: func(_ *common.Config) bool { return true },
+					Config: common.MustNewConfigFrom(map[string]interface{}{
+						"p4dbeat.import.files": args,
+					}),
+				}},
+			}
+			if err := instance.Run(settings, beater.New); err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+}
+
+func init() {
+	RootCmd.AddCommand(genImportCmd())
+}
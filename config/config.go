@@ -3,22 +3,507 @@
 
 package config
 
-import "time"
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/conditions"
+)
+
+// Parser names for inputs
+const (
+	ParserP4dLog             = "p4dlog"              // p4d text log
+	ParserStructuredCommands = "structured_commands" // p4d structured logs (serverlog.file.N), e.g. commands.csv
+	ParserStructuredErrors   = "structured_errors"   // errors.csv
+	ParserStructuredEvents   = "structured_events"   // events.csv
+	ParserStructuredAudit    = "structured_audit"    // audit.csv
+	ParserP4Audit            = "p4audit"             // P4AUDIT file access log
+)
 
 type Registry struct {
-	Path string `config:path`
+	Path string `config:"path"`
+}
+
+// Input - a single p4d log (or glob of logs) to be tailed
+type Input struct {
+	Path           string        `config:"path"`
+	RotatedPattern string        `config:"rotated_pattern"` // glob to find rotated logs - defaults to "<path>.*"
+	StateKey       string        `config:"state_key"`       // key for offset state - defaults to path
+	Parser         string        `config:"parser"`
+	Fields         common.MapStr `config:"fields"`   // static fields added to every event, e.g. p4.serverid
+	Timezone       string        `config:"timezone"` // timezone of the server writing the log - defaults to top level setting
+	AuditRollup    AuditRollup   `config:"audit_rollup"`
+}
+
+// AuditRollup - for p4audit inputs, bulk file accesses (e.g. syncs of many files) are published
+// as a single summary event rather than one event per file
+type AuditRollup struct {
+	Enabled   bool          `config:"enabled"`
+	Actions   []string      `config:"actions"`   // actions to roll up - defaults to sync
+	Threshold int           `config:"threshold"` // minimum number of files for a summary - defaults to 100
+	Window    time.Duration `config:"window"`    // a bulk access ends after this gap between files - defaults to 5s
+}
+
+// Validate - called by Unpack
+func (i *Input) Validate() error {
+	if i.Path == "" {
+		return fmt.Errorf("input path must be specified")
+	}
+	if _, err := time.LoadLocation(i.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone for input '%s': %v", i.Path, err)
+	}
+	switch i.Parser {
+	case "", ParserP4dLog, ParserStructuredCommands, ParserStructuredErrors,
+		ParserStructuredEvents, ParserStructuredAudit, ParserP4Audit:
+	default:
+		return fmt.Errorf("unknown parser '%s' for input '%s'", i.Parser, i.Path)
+	}
+	return nil
+}
+
+// Import - one-shot historical import of archived logs (plain, gzip or bzip2)
+type Import struct {
+	Files    []string      `config:"files"`    // if set, these are imported and p4dbeat then exits
+	Fields   common.MapStr `config:"fields"`   // static fields added to every event, e.g. p4.serverid
+	Timezone string        `config:"timezone"` // timezone of the server which wrote the logs - defaults to top level setting
+}
+
+// JSONInput - local listener for command-like JSON records, e.g. from triggers or wrapper scripts
+type JSONInput struct {
+	Host        string        `config:"host"`          // HTTP listen address, e.g. localhost:5080 - not enabled if empty
+	Socket      string        `config:"socket"`        // Unix socket path for HTTP requests - not enabled if empty
+	MaxBodySize int64         `config:"max_body_size"` // maximum size of a request in bytes
+	Fields      common.MapStr `config:"fields"`        // static fields added to every event
+}
+
+// Rollup - periodic aggregate events per (cmd, user, app), published every period
+type Rollup struct {
+	Enabled bool `config:"enabled"`
+}
+
+// Percentiles - latency percentile summaries per command, published every interval
+type Percentiles struct {
+	Enabled     bool          `config:"enabled"`
+	Interval    time.Duration `config:"interval"`
+	Percentiles []float64     `config:"percentiles"` // defaults to 50, 95, 99
+}
+
+// Validate - called by Unpack
+func (p *Percentiles) Validate() error {
+	if p.Interval <= 0 {
+		return fmt.Errorf("percentiles interval must be positive")
+	}
+	for _, v := range p.Percentiles {
+		if v <= 0 || v > 100 {
+			return fmt.Errorf("invalid percentile %v - must be greater than 0 and at most 100", v)
+		}
+	}
+	return nil
+}
+
+// Contention - analysis of table lock waits, linking commands which waited to the likely blockers
+type Contention struct {
+	Enabled     bool          `config:"enabled"`
+	Threshold   time.Duration `config:"threshold"`    // minimum wait for a table lock to be analysed
+	MaxBlockers int           `config:"max_blockers"` // most likely blockers reported
+	Delay       time.Duration `config:"delay"`        // wait for blockers output after the waiting command
+	Retention   time.Duration `config:"retention"`    // how long (log time) lock holders are kept for
+}
+
+// AlertRule - a condition checked against every command, publishing a p4.alert event if it matches
+type AlertRule struct {
+	Name        string            `config:"name"`
+	Severity    string            `config:"severity"` // defaults to warning
+	Description string            `config:"description"`
+	When        conditions.Config `config:"when"` // libbeat condition on the command event fields
+}
+
+// Validate - called by Unpack
+func (r *AlertRule) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("alert rule name must be specified")
+	}
+	if _, err := conditions.NewCondition(&r.When); err != nil {
+		return fmt.Errorf("invalid condition for alert rule '%s': %v", r.Name, err)
+	}
+	return nil
+}
+
+// Notifier formats
+const (
+	NotifierJSON  = "json"  // generic JSON
+	NotifierSlack = "slack" // Slack incoming webhook
+	NotifierTeams = "teams" // Microsoft Teams incoming webhook
+)
+
+// Notifier - POSTs alerts to a webhook, grouped per rule within the window
+type Notifier struct {
+	Enabled    bool              `config:"enabled"`
+	URL        string            `config:"url"`
+	Format     string            `config:"format"`
+	Headers    map[string]string `config:"headers"`
+	Window     time.Duration     `config:"window"`      // alerts for a rule are grouped into one notification per window
+	Timeout    time.Duration     `config:"timeout"`     // for each request
+	Backoff    time.Duration     `config:"backoff"`     // delay before the first retry, doubled each time
+	MaxBackoff time.Duration     `config:"max_backoff"` // maximum delay between retries
+	MaxPending int               `config:"max_pending"` // oldest unsent notifications are dropped beyond this
+	DedupTTL   time.Duration     `config:"dedup_ttl"`   // alerts with the same ID are only notified once within this
+}
+
+// Validate - called by Unpack
+func (n *Notifier) Validate() error {
+	if n.Enabled && n.URL == "" {
+		return fmt.Errorf("notifier url must be specified")
+	}
+	switch n.Format {
+	case NotifierJSON, NotifierSlack, NotifierTeams:
+	default:
+		return fmt.Errorf("invalid notifier format '%s' - must be one of json, slack or teams", n.Format)
+	}
+	if n.Window <= 0 || n.Timeout <= 0 || n.Backoff <= 0 || n.MaxBackoff <= 0 || n.DedupTTL <= 0 {
+		return fmt.Errorf("notifier window, timeout, backoff and dedup_ttl must be positive")
+	}
+	return nil
 }
 
+// Metrics - Prometheus /metrics endpoint with counters and histograms from the parsed commands
+type Metrics struct {
+	Enabled        bool      `config:"enabled"`
+	Host           string    `config:"host"`
+	Labels         []string  `config:"labels"`           // command labels: any of cmd, user, app - defaults to cmd
+	MaxLabelValues int       `config:"max_label_values"` // further values of each label are counted as "other"
+	Buckets        []float64 `config:"buckets"`          // for the command duration histogram (seconds) - has defaults
+}
+
+// Validate - called by Unpack
+func (m *Metrics) Validate() error {
+	for _, l := range m.Labels {
+		switch l {
+		case "cmd", "user", "app":
+		default:
+			return fmt.Errorf("invalid metrics label '%s' - must be one of cmd, user or app", l)
+		}
+	}
+	return nil
+}
+
+// Subnet maps client IPs in a CIDR range to a site
+type Subnet struct {
+	CIDR    string `config:"cidr" validate:"required"`
+	Site    string `config:"site"`
+	Region  string `config:"region"`
+	Network string `config:"network"` // network type, e.g. office, vpn, cloud
+}
+
+// Validate - called by Unpack
+func (s *Subnet) Validate() error {
+	if _, _, err := net.ParseCIDR(s.CIDR); err != nil {
+		return fmt.Errorf("invalid subnet '%s': %v", s.CIDR, err)
+	}
+	return nil
+}
+
+// Sites - the site of client and proxy IPs. Subnets can be listed or read from a file,
+// either YAML (with a subnets list as here) or CSV (cidr,site,region,network)
+type Sites struct {
+	Subnets []Subnet `config:"subnets"`
+	File    string   `config:"file"`
+}
+
+// GeoIP - lookups of client and proxy IPs in a local MaxMind database (GeoLite2/GeoIP2 City or Country)
+type GeoIP struct {
+	Database string `config:"database"`
+}
+
+// User directory file formats
+const (
+	UsersCSV  = "csv"  // header row naming the columns, one of which is "user"
+	UsersZtag = "ztag" // p4 -ztag users and/or p4 -ztag groups output
+	UsersLDIF = "ldif" // LDAP export
+)
+
+// UsersFile is a file of user attributes or group memberships
+type UsersFile struct {
+	Path   string `config:"path" validate:"required"`
+	Format string `config:"format"` // csv, ztag or ldif - defaults from the file extension (.csv, .ldif, otherwise ztag)
+}
+
+// Validate - called by Unpack
+func (f *UsersFile) Validate() error {
+	switch f.Format {
+	case "", UsersCSV, UsersZtag, UsersLDIF:
+		return nil
+	}
+	return fmt.Errorf("invalid users file format '%s' - must be one of csv, ztag or ldif", f.Format)
+}
+
+// Users - attributes of p4.user (department, manager, team, account type...) from local files.
+// The files are reloaded in the background when they change.
+type Users struct {
+	Files          []UsersFile       `config:"files"`           // later files override attributes from earlier ones
+	Attributes     map[string]string `config:"attributes"`      // CSV column/LDIF attribute to field name, e.g. ou: team
+	ReloadInterval time.Duration     `config:"reload_interval"` // how often to check the files for changes
+}
+
+// Privacy modes for fields
+const (
+	PrivacyKeep  = "keep"
+	PrivacyHash  = "hash"  // replaced by a keyed HMAC token - the same value always gives the same token
+	PrivacyDrop  = "drop"  // not published
+	PrivacyScrub = "scrub" // args only - patterns replaced and/or truncated
+)
+
+// PrivacyArgs - how p4.args is published
+type PrivacyArgs struct {
+	Mode        string   `config:"mode"`        // keep, scrub, hash or drop
+	Patterns    []string `config:"patterns"`    // regexes replaced when scrubbing
+	Replacement string   `config:"replacement"` // replacement for the patterns
+	MaxLength   int      `config:"max_length"`  // args truncated to this length when scrubbing, if set
+}
+
+// Privacy - pseudonymise personal data in command (and audit) events before they are published
+type Privacy struct {
+	Key       string      `config:"key"`      // HMAC key - required to hash any field
+	KeyFile   string      `config:"key_file"` // or a file containing the key
+	User      string      `config:"user"`     // keep, hash or drop
+	Workspace string      `config:"workspace"`
+	IP        string      `config:"ip"`
+	Args      PrivacyArgs `config:"args"`
+}
+
+// Validate - called by Unpack
+func (p *Privacy) Validate() error {
+	hashed := false
+	for name, mode := range map[string]string{"user": p.User, "workspace": p.Workspace, "ip": p.IP, "args": p.Args.Mode} {
+		switch mode {
+		case PrivacyKeep, PrivacyDrop:
+		case PrivacyHash:
+			hashed = true
+		case PrivacyScrub:
+			if name != "args" {
+				return fmt.Errorf("invalid privacy mode '%s' for %s - must be one of keep, hash or drop", mode, name)
+			}
+		default:
+			return fmt.Errorf("invalid privacy mode '%s' for %s", mode, name)
+		}
+	}
+	if hashed && p.Key == "" && p.KeyFile == "" {
+		return fmt.Errorf("privacy key or key_file is required to hash fields")
+	}
+	for _, re := range p.Args.Patterns {
+		if _, err := regexp.Compile(re); err != nil {
+			return fmt.Errorf("invalid privacy args pattern '%s': %v", re, err)
+		}
+	}
+	return nil
+}
+
+// CommandFilter matches commands. All the criteria which are set must match, and for lists
+// any of the values. Cmds, users and apps are regexes which must match the whole value.
+type CommandFilter struct {
+	Cmds         []string      `config:"cmds"`
+	Users        []string      `config:"users"`
+	Apps         []string      `config:"apps"`
+	IPs          []string      `config:"ips"`           // CIDRs or IPs, matching the client or proxy IP
+	MinCompleted time.Duration `config:"min_completed"` // CompletedLapse at least this
+	MaxCompleted time.Duration `config:"max_completed"` // CompletedLapse less than this
+}
+
+// Validate - called by Unpack
+func (f *CommandFilter) Validate() error {
+	for _, list := range [][]string{f.Cmds, f.Users, f.Apps} {
+		for _, re := range list {
+			if _, err := regexp.Compile(re); err != nil {
+				return fmt.Errorf("invalid filter pattern '%s': %v", re, err)
+			}
+		}
+	}
+	for _, ip := range f.IPs {
+		if net.ParseIP(ip) == nil {
+			if _, _, err := net.ParseCIDR(ip); err != nil {
+				return fmt.Errorf("invalid filter IP '%s': %v", ip, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Filters - which commands are published. If there are include filters only commands matching
+// one of them are published, and commands matching any exclude filter are dropped.
+type Filters struct {
+	Include []CommandFilter `config:"include"`
+	Exclude []CommandFilter `config:"exclude"`
+}
+
+// SampleRule - keep 1 in Rate of the matching commands which are faster than MaxCompleted
+type SampleRule struct {
+	Cmds         []string      `config:"cmds" validate:"required"` // regexes matching the whole cmd
+	MaxCompleted time.Duration `config:"max_completed"`            // slower commands are always kept
+	Rate         int           `config:"rate" validate:"min=1"`
+}
+
+// Validate - called by Unpack
+func (r *SampleRule) Validate() error {
+	for _, re := range r.Cmds {
+		if _, err := regexp.Compile(re); err != nil {
+			return fmt.Errorf("invalid sampling pattern '%s': %v", re, err)
+		}
+	}
+	return nil
+}
+
+// Sampling - publish a sample of high volume fast commands. Failed commands and those which
+// waited for or held locks for at least LockThreshold are always kept. If MaxEventsPerSecond
+// is set the rates are raised (up to MaxRate, or without limit if 0) to keep the events
+// published under it.
+type Sampling struct {
+	Rules              []SampleRule  `config:"rules"`
+	LockThreshold      time.Duration `config:"lock_threshold"`
+	MaxEventsPerSecond int           `config:"max_events_per_second"`
+	MaxRate            int           `config:"max_rate"`
+	Window             time.Duration `config:"window"` // time the events per second are measured over
+}
+
+// Tables - which tables have p4.tbl.<table>.* fields published, to keep the index under its
+// field limit. If Include is set only matching tables are published, less any matching Exclude.
+type Tables struct {
+	Include []string `config:"include"` // regexes matching the whole (lower case) table name
+	Exclude []string `config:"exclude"`
+}
+
+// Validate - called by Unpack
+func (t *Tables) Validate() error {
+	for _, re := range append(append([]string{}, t.Include...), t.Exclude...) {
+		if _, err := regexp.Compile(re); err != nil {
+			return fmt.Errorf("invalid table pattern '%s': %v", re, err)
+		}
+	}
+	return nil
+}
+
+// Event timestamp options
+const (
+	TimestampStart = "start" // command start time
+	TimestampEnd   = "end"   // command end time
+	TimestampNow   = "now"   // time the event is published
+)
+
+// Field schema options
+const (
+	SchemaP4   = "p4"   // p4.* fields
+	SchemaECS  = "ecs"  // ECS fields instead of the p4.* fields they map to
+	SchemaBoth = "both" // ECS fields as well as all p4.* fields
+)
+
 // Config - P4dbeat config
 type Config struct {
-	Period    time.Duration `config:"period"`
-	Path      string        `config:"path"`
-	StatePath string        `config:"statepath"`
+	Period          time.Duration `config:"period"` // length of the rollup periods
+	Path            string        `config:"path"`
+	RotatedPattern  string        `config:"rotated_pattern"` // glob to find rotated logs - defaults to "<path>.*"
+	StatePath       string        `config:"statepath"`
+	Inputs          []Input       `config:"inputs"`         // if set then path/rotated_pattern are ignored
+	ScanFrequency   time.Duration `config:"scan_frequency"` // how often input globs are re-expanded
+	Import          Import        `config:"import"`
+	JSONInput       JSONInput     `config:"json_input"`
+	Rollup          Rollup        `config:"rollup"`
+	Percentiles     Percentiles   `config:"percentiles"`
+	Contention      Contention    `config:"contention"`
+	Alerts          []AlertRule   `config:"alerts"`
+	Notifier        Notifier      `config:"notifier"`
+	Metrics         Metrics       `config:"metrics"`
+	Sites           Sites         `config:"sites"`
+	GeoIP           GeoIP         `config:"geoip"`
+	Users           Users         `config:"users"`
+	Privacy         Privacy       `config:"privacy"`
+	Filters         Filters       `config:"filters"`
+	Sampling        Sampling      `config:"sampling"`
+	Tables          Tables        `config:"tables"`
+	DeterministicID bool          `config:"deterministic_id"` // document IDs derived from the command so re-reads don't duplicate
+	Timestamp       string        `config:"timestamp"`        // start, end or now
+	Timezone        string        `config:"timezone"`         // timezone of the p4d server - p4d logs local time
+	Schema          string        `config:"schema"`           // p4, ecs or both
+}
+
+// Validate - called by Unpack
+func (c *Config) Validate() error {
+	switch c.Timestamp {
+	case TimestampStart, TimestampEnd, TimestampNow:
+	default:
+		return fmt.Errorf("invalid timestamp '%s' - must be one of start, end or now", c.Timestamp)
+	}
+	switch c.Schema {
+	case SchemaP4, SchemaECS, SchemaBoth:
+	default:
+		return fmt.Errorf("invalid schema '%s' - must be one of p4, ecs or both", c.Schema)
+	}
+	if c.Rollup.Enabled && c.Period < time.Minute {
+		return fmt.Errorf("period must be at least 1m when rollups are enabled")
+	}
+	if c.ScanFrequency <= 0 {
+		return fmt.Errorf("scan_frequency must be positive")
+	}
+	if _, err := time.LoadLocation(c.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone: %v", err)
+	}
+	if _, err := time.LoadLocation(c.Import.Timezone); err != nil {
+		return fmt.Errorf("invalid import timezone: %v", err)
+	}
+	return nil
 }
 
 // DefaultConfig - default values for P4dbeat
 var DefaultConfig = Config{
-	Period:    1 * time.Second,
-	Path:      "/p4/1/logs/log",
-	StatePath: "state", // relative to cwd
+	Period:          1 * time.Hour,
+	Path:            "/p4/1/logs/log",
+	StatePath:       "state", // relative to cwd
+	ScanFrequency:   10 * time.Second,
+	DeterministicID: true,
+	Timestamp:       TimestampStart,
+	Timezone:        "Local",
+	Schema:          SchemaP4,
+	JSONInput: JSONInput{
+		MaxBodySize: 1024 * 1024,
+	},
+	Percentiles: Percentiles{
+		Interval: 1 * time.Minute,
+	},
+	Contention: Contention{
+		Threshold:   1 * time.Second,
+		MaxBlockers: 3,
+		Delay:       10 * time.Second,
+		Retention:   10 * time.Minute,
+	},
+	Notifier: Notifier{
+		Format:     NotifierJSON,
+		Window:     1 * time.Minute,
+		Timeout:    10 * time.Second,
+		Backoff:    1 * time.Second,
+		MaxBackoff: 5 * time.Minute,
+		MaxPending: 1000,
+		DedupTTL:   24 * time.Hour,
+	},
+	Metrics: Metrics{
+		Host:           "localhost:9101",
+		MaxLabelValues: 500,
+	},
+	Users: Users{
+		ReloadInterval: 1 * time.Minute,
+	},
+	Sampling: Sampling{
+		LockThreshold: 1 * time.Second,
+		MaxRate:       1000,
+		Window:        10 * time.Second,
+	},
+	Privacy: Privacy{
+		User:      PrivacyKeep,
+		Workspace: PrivacyKeep,
+		IP:        PrivacyKeep,
+		Args: PrivacyArgs{
+			Mode:        PrivacyKeep,
+			Replacement: "<redacted>",
+		},
+	},
 }
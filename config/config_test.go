@@ -1,3 +1,64 @@
 // +build !integration
 
 package config
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// Arrays are merged index-wise with the defaults when unpacking, so list options default in the
+// beater (e.g. percentiles) rather than DefaultConfig, or [90] would become [90, 95, 99].
+func TestPercentilesReplaceDefaults(t *testing.T) {
+	tests := []struct {
+		name   string
+		config map[string]interface{}
+		want   []float64
+	}{
+		{"unset", map[string]interface{}{"percentiles.enabled": true}, nil},
+		{"one", map[string]interface{}{"percentiles.percentiles": []float64{90}}, []float64{90}},
+		{"four", map[string]interface{}{"percentiles.percentiles": []float64{50, 90, 99, 99.9}}, []float64{50, 90, 99, 99.9}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := common.NewConfigFrom(tt.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c := DefaultConfig
+			if err := cfg.Unpack(&c); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(c.Percentiles.Percentiles, tt.want) {
+				t.Errorf("percentiles = %v, want %v", c.Percentiles.Percentiles, tt.want)
+			}
+		})
+	}
+}
+
+func TestRollupPeriod(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  map[string]interface{}
+		wantErr bool
+	}{
+		{"default", map[string]interface{}{"rollup.enabled": true}, false},
+		{"1m", map[string]interface{}{"rollup.enabled": true, "period": "1m"}, false},
+		{"too short", map[string]interface{}{"rollup.enabled": true, "period": "1s"}, true},
+		{"rollups disabled", map[string]interface{}{"period": "1s"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := common.NewConfigFrom(tt.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c := DefaultConfig
+			if err := cfg.Unpack(&c); (err != nil) != tt.wantErr {
+				t.Errorf("unpack error %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -35,10 +35,12 @@ require (
 	github.com/miekg/dns v1.1.31 // indirect
 	github.com/mitchellh/hashstructure v1.0.0 // indirect
 	github.com/opencontainers/go-digest v1.0.0 // indirect
+	github.com/oschwald/geoip2-golang v1.4.0
+	github.com/prometheus/client_golang v1.7.1
 	github.com/prometheus/procfs v0.1.3 // indirect
 	github.com/rcowham/go-libp4dlog v0.8.1
 	github.com/sirupsen/logrus v1.6.0
-	github.com/spf13/cobra v1.0.0 // indirect
+	github.com/spf13/cobra v1.0.0
 	github.com/urso/magetools v0.0.0-20200125210132-c2e338f92f3a // indirect
 	go.uber.org/multierr v1.6.0 // indirect
 	go.uber.org/zap v1.16.0 // indirect